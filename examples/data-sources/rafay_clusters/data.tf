@@ -6,3 +6,13 @@ output "cluster_list" {
   description = "clusters list"
   value       = data.rafay_clusters.list.clusters
 }
+
+data "rafay_clusters" "healthy_prod" {
+  projectname    = "defaultproject"
+  label_selector = "env=prod"
+  health         = "HEALTHY"
+}
+
+output "prod_kubernetes_versions" {
+  value = { for c in data.rafay_clusters.healthy_prod.clusters : c.clustername => c.kubernetes_version }
+}
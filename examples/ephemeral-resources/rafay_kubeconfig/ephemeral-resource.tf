@@ -0,0 +1,12 @@
+# Fetch the kubeconfig for a cluster without writing it to disk or state.
+ephemeral "rafay_kubeconfig" "demo" {
+  cluster = "demo-cluster"
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.rafay_kubeconfig.demo.host
+  cluster_ca_certificate = ephemeral.rafay_kubeconfig.demo.cluster_ca_certificate
+  client_certificate     = ephemeral.rafay_kubeconfig.demo.client_certificate
+  client_key             = ephemeral.rafay_kubeconfig.demo.client_key
+  token                  = ephemeral.rafay_kubeconfig.demo.token
+}
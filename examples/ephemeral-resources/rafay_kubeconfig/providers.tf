@@ -0,0 +1,19 @@
+terraform {
+  required_version = ">= 1.10"
+  required_providers {
+    rafay = {
+      version = ">= 0.1"
+      source  = "registry.terraform.io/RafaySystems/rafay"
+    }
+  }
+}
+
+provider "rafay" {
+  provider_config_file = var.rafay_config_file
+}
+
+variable "rafay_config_file" {
+  description = "rafay provider config file for authentication"
+  sensitive   = true
+  default     = "~/.rafay/cli/config.json"
+}
@@ -0,0 +1,12 @@
+variable "blueprint_version" {
+  type    = string
+  default = "v2"
+}
+
+# Require blueprint version v1 or later.
+check "blueprint_version" {
+  assert {
+    condition     = provider::rafay::blueprint_version_compare(var.blueprint_version, "v1") >= 0
+    error_message = "The blueprint version must be v1 or later."
+  }
+}
@@ -0,0 +1,12 @@
+# Decode a kubeconfig downloaded with rafay_download_kubeconfig.
+locals {
+  kubeconfig = provider::rafay::kubeconfig_decode(file("${path.module}/kubeconfig.yaml"), "demo-cluster")
+}
+
+provider "kubernetes" {
+  host                   = local.kubeconfig.host
+  cluster_ca_certificate = local.kubeconfig.cluster_ca_certificate
+  client_certificate     = local.kubeconfig.client_certificate
+  client_key             = local.kubeconfig.client_key
+  token                  = local.kubeconfig.token
+}
@@ -0,0 +1,8 @@
+# Split an import ID of the form name/project.
+locals {
+  cluster = provider::rafay::parse_cluster_id("demo-cluster/defaultproject")
+}
+
+output "cluster_project" {
+  value = local.cluster.project # "defaultproject"
+}
@@ -0,0 +1,12 @@
+# Manage a cluster created from an rctl spec file with rafay_eks_cluster.
+locals {
+  spec = provider::rafay::yaml_to_cluster_spec(file("${path.module}/eks-cluster.yaml"))
+}
+
+output "cluster" {
+  value = local.spec.cluster
+}
+
+output "cluster_config" {
+  value = local.spec.cluster_config
+}
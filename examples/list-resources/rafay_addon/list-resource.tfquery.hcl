@@ -0,0 +1,7 @@
+list "rafay_addon" "all" {
+  provider = rafay
+
+  config {
+    project = "defaultproject"
+  }
+}
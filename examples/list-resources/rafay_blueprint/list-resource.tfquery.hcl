@@ -0,0 +1,7 @@
+list "rafay_blueprint" "all" {
+  provider = rafay
+
+  config {
+    project = "defaultproject"
+  }
+}
@@ -0,0 +1,7 @@
+list "rafay_cloud_credentials_v3" "all" {
+  provider = rafay
+
+  config {
+    project = "defaultproject"
+  }
+}
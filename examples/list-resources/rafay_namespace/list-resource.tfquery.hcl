@@ -0,0 +1,7 @@
+list "rafay_namespace" "all" {
+  provider = rafay
+
+  config {
+    project = "defaultproject"
+  }
+}
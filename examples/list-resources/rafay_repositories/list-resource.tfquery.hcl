@@ -0,0 +1,7 @@
+list "rafay_repositories" "all" {
+  provider = rafay
+
+  config {
+    project = "defaultproject"
+  }
+}
@@ -0,0 +1,7 @@
+list "rafay_workload" "all" {
+  provider = rafay
+
+  config {
+    project = "defaultproject"
+  }
+}
@@ -25,7 +25,8 @@ resource "rafay_import_cluster" "import_cluster" {
 }
 
 output "values_data" {
-  value = rafay_import_cluster.import_cluster.values_data
+  value     = rafay_import_cluster.import_cluster.values_data
+  sensitive = true
 }
 
 output "values_path" {
@@ -33,7 +34,8 @@ output "values_path" {
 }
 
 output "bootstrap_data" {
-  value = rafay_import_cluster.import_cluster.bootstrap_data
+  value     = rafay_import_cluster.import_cluster.bootstrap_data
+  sensitive = true
 }
 
 output "bootstrap_path" {
@@ -1,26 +1,26 @@
 #Basic example for namespace
 resource "rafay_namespace" "tfdemonamespace1" {
-  metadata {
+  metadata = {
     name    = "tfdemonamespace1"
     project = "terraform"
   }
-  spec {
-    drift {
+  spec = {
+    drift = {
       enabled = false
     }
     #should be placed on a valid cluster
-    placement {
-      labels {
+    placement = {
+      labels = [{
         key   = "rafay.dev/clusterName"
         value = "cluster_name"
-      }
+      }]
     }
   }
 }
 
 #Namespace example with resource quotas & limit ranges
 resource "rafay_namespace" "namespace" {
-  metadata {
+  metadata = {
     name    = "cloudops"
     project = "terraform"
     labels = {
@@ -30,56 +30,56 @@ resource "rafay_namespace" "namespace" {
       "logging" = "enabled"
     }
   }
-  spec {
-    drift {
+  spec = {
+    drift = {
       enabled = false
     }
-    placement {
-      labels {
+    placement = {
+      labels = [{
         key   = "rafay.dev/clusterName"
         value = "cluster_name"
-      }
+      }]
     }
-    limit_range {
-      pod {
-        max {
+    limit_range = {
+      pod = {
+        max = {
           cpu    = "500m"
           memory = "128Mi"
         }
-        min {
+        min = {
           cpu    = "250m"
           memory = "64Mi"
         }
-        ratio {
+        ratio = {
           cpu    = 1
           memory = 1
         }
       }
-      container {
-        default {
+      container = {
+        default = {
           cpu    = "250m"
           memory = "64Mi"
         }
-        default_request {
+        default_request = {
           cpu    = "250m"
           memory = "64Mi"
         }
 
-        max {
+        max = {
           cpu    = "500m"
           memory = "128Mi"
         }
-        min {
+        min = {
           cpu    = "250m"
           memory = "64Mi"
         }
-        ratio {
+        ratio = {
           cpu    = 1
           memory = 1
         }
       }
     }
-    resource_quotas {
+    resource_quotas = {
       config_maps                = "10"
       cpu_limits                 = "8000m"
       memory_limits              = "16384Mi"
@@ -98,12 +98,12 @@ resource "rafay_namespace" "namespace" {
       ephemeral_storage_limits   = "15Mi"
       ephemeral_storage_requests = "5Mi"
     }
-    network_policy_params {
+    network_policy_params = {
       network_policy_enabled = true
-      policies {
+      policies = [{
         name    = "namespace_network_policy_name"
         version = "v0"
-      }
+      }]
     }
   }
 }
\ No newline at end of file
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &BlueprintVersionCompareFunction{}
+
+// latestBlueprintVersion is the version that tracks the newest release of a
+// blueprint, newer than any numbered one. It is matched case insensitively
+// as configurations use both latest and Latest.
+const latestBlueprintVersion = "latest"
+
+func NewBlueprintVersionCompareFunction() function.Function {
+	return &BlueprintVersionCompareFunction{}
+}
+
+// BlueprintVersionCompareFunction orders blueprint versions such as v0,
+// 4.1.0 and latest.
+type BlueprintVersionCompareFunction struct{}
+
+func (f *BlueprintVersionCompareFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "blueprint_version_compare"
+}
+
+func (f *BlueprintVersionCompareFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compare two blueprint versions",
+		Description: "Returns -1, 0 or 1 when the first blueprint version is older than, the same as or newer than the second. " +
+			"Versions are semantic versions with an optional `v` prefix, such as `v0` or `4.1.0`; `latest`, in any case, is newer than any of them.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "Blueprint version to compare.",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "Blueprint version to compare against.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *BlueprintVersionCompareFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+
+	va, err := parseBlueprintVersion(a)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+	}
+	vb, err := parseBlueprintVersion(b)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(1, err.Error()))
+	}
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, int64(compareBlueprintVersions(va, vb)))
+}
+
+// parseBlueprintVersion parses v, returning nil for latest.
+func parseBlueprintVersion(v string) (*version.Version, error) {
+	if strings.EqualFold(v, latestBlueprintVersion) {
+		return nil, nil
+	}
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blueprint version %q: expected a version such as v0, 4.1.0 or %s", v, latestBlueprintVersion)
+	}
+	return parsed, nil
+}
+
+func compareBlueprintVersions(a, b *version.Version) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return 1
+	case b == nil:
+		return -1
+	}
+	return a.Compare(b)
+}
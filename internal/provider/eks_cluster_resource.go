@@ -31,6 +31,9 @@ func NewEksClusterResource() resource.Resource {
 	return &eksClusterResource{}
 }
 
+// defaultEksTimeout applies to any operation the `timeouts` block leaves unset.
+const defaultEksTimeout = 60 * time.Minute
+
 type eksClusterResource struct{}
 
 func (r *eksClusterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -198,19 +201,12 @@ func (r *eksClusterResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	tm := "60m"
-	if !data.Timeouts.IsNull() && !data.Timeouts.Create.IsNull() && !data.Timeouts.Create.IsUnknown() {
-		tm = data.Timeouts.Create.ValueString()
-	}
-	timeouts, err := time.ParseDuration(tm)
-	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic(
-			"Timeout Cannot Be Parsed",
-			fmt.Sprintf("timeout for %q cannot be parsed, %s", timeouts, err),
-		))
+	timeout, d := operationTimeout(data.Timeouts.Create, defaultEksTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	ctx, cancel := context.WithTimeout(ctx, timeouts)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	newCluster, d := resource_eks_cluster.ExpandEksCluster(ctx, data)
@@ -282,7 +278,7 @@ func (r *eksClusterResource) Create(ctx context.Context, req resource.CreateRequ
 
 	data.Id = types.StringValue(s.ID)
 
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
+	ticker := time.NewTicker(pollInterval(timeout))
 	defer ticker.Stop()
 LOOP:
 	for {
@@ -293,7 +289,7 @@ LOOP:
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("cluster operation stopped for cluster: `%s` due to operation timeout", clusterName))
 			return
 		case <-ticker.C:
-			log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting 60 seconds more for cluster to complete the operation.", clusterName, projectName)
+			log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting %s more for cluster to complete the operation.", clusterName, projectName, pollInterval(timeout))
 			check, errGet := cluster.GetCluster(newCluster.Metadata.Name, projectID, uaDef)
 			if errGet != nil {
 				log.Printf("error while getCluster %s", errGet.Error())
@@ -344,7 +340,7 @@ LOOP:
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create/update cluster while provisioning cluster %s %s", clusterName, statusResp))
 				return
 			} else {
-				log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting 60 seconds more for cluster to complete the operation.", clusterName, projectName)
+				log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting %s more for cluster to complete the operation.", clusterName, projectName, pollInterval(timeout))
 			}
 		}
 	}
@@ -515,23 +511,16 @@ func (r *eksClusterResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	tm := "60m"
-	if !data.Timeouts.IsNull() && !data.Timeouts.Create.IsNull() && !data.Timeouts.Create.IsUnknown() {
-		tm = data.Timeouts.Create.ValueString()
-	}
-	timeouts, err := time.ParseDuration(tm)
-	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic(
-			"Timeout Cannot Be Parsed",
-			fmt.Sprintf("timeout for %q cannot be parsed, %s", timeouts, err),
-		))
+	timeout, d := operationTimeout(data.Timeouts.Update, defaultEksTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	ctx, cancel := context.WithTimeout(ctx, timeouts)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	clusterEls := make([]resource_eks_cluster.ClusterValue, 0, len(data.Cluster.Elements()))
-	d := data.Cluster.ElementsAs(ctx, &clusterEls, false)
+	d = data.Cluster.ElementsAs(ctx, &clusterEls, false)
 	if d.HasError() {
 		resp.Diagnostics.Append(d...)
 		return
@@ -763,7 +752,7 @@ func (r *eksClusterResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
+	ticker := time.NewTicker(pollInterval(timeout))
 	defer ticker.Stop()
 LOOP:
 	for {
@@ -774,7 +763,7 @@ LOOP:
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("cluster operation stopped for cluster: `%s` due to operation timeout", clusterName))
 			return
 		case <-ticker.C:
-			log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting 60 seconds more for cluster to complete the operation.", clusterName, projectName)
+			log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting %s more for cluster to complete the operation.", clusterName, projectName, pollInterval(timeout))
 			check, errGet := cluster.GetCluster(updatedCluster.Metadata.Name, projectID, uaDef)
 			if errGet != nil {
 				log.Printf("error while getCluster %s", errGet.Error())
@@ -825,7 +814,7 @@ LOOP:
 				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("failed to create/update cluster while provisioning cluster %s %s", clusterName, statusResp))
 				return
 			} else {
-				log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting 60 seconds more for cluster to complete the operation.", clusterName, projectName)
+				log.Printf("Cluster operation not completed for cluster: %s and project: %s. Waiting %s more for cluster to complete the operation.", clusterName, projectName, pollInterval(timeout))
 			}
 		}
 	}
@@ -875,24 +864,17 @@ func (r *eksClusterResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	tm := "60m"
-	if !data.Timeouts.IsNull() && !data.Timeouts.Create.IsNull() && !data.Timeouts.Create.IsUnknown() {
-		tm = data.Timeouts.Create.ValueString()
-	}
-	timeouts, err := time.ParseDuration(tm)
-	if err != nil {
-		resp.Diagnostics.Append(diag.NewErrorDiagnostic(
-			"Timeout Cannot Be Parsed",
-			fmt.Sprintf("timeout for %q cannot be parsed, %s", timeouts, err),
-		))
+	timeout, d := operationTimeout(data.Timeouts.Delete, defaultEksTimeout)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	ctx, cancel := context.WithTimeout(ctx, timeouts)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	// Get cluster from state
 	clusterList := make([]resource_eks_cluster.ClusterValue, 0, len(data.Cluster.Elements()))
-	d := data.Cluster.ElementsAs(ctx, &clusterList, false)
+	d = data.Cluster.ElementsAs(ctx, &clusterList, false)
 	if d.HasError() {
 		resp.Diagnostics.Append(d...)
 		return
@@ -944,7 +926,7 @@ func (r *eksClusterResource) Delete(ctx context.Context, req resource.DeleteRequ
 		)
 	}
 
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
+	ticker := time.NewTicker(pollInterval(timeout))
 	defer ticker.Stop()
 
 LOOP:
@@ -963,7 +945,7 @@ LOOP:
 			if check == nil {
 				break LOOP
 			}
-			log.Printf("Cluster Deletion is in progress for cluster: %s and project: %s. Waiting 60 seconds more for operation to complete.", clusterName, projectName)
+			log.Printf("Cluster Deletion is in progress for cluster: %s and project: %s. Waiting %s more for operation to complete.", clusterName, projectName, pollInterval(timeout))
 		}
 	}
 	log.Printf("Cluster Deletion completes for cluster: %s and project: %s", clusterName, projectName)
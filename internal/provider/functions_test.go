@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseClusterIDFunction(t *testing.T) {
+	cases := []struct {
+		id      string
+		want    attr.Value
+		wantErr bool
+	}{
+		{id: "c1/defaultproject", want: types.ObjectValueMust(clusterIDAttrTypes, map[string]attr.Value{
+			"name":    types.StringValue("c1"),
+			"project": types.StringValue("defaultproject"),
+		})},
+		{id: "c1", wantErr: true},
+		{id: "c1/", wantErr: true},
+		{id: "a/b/c", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.id, func(t *testing.T) {
+			resp := runFunction(t, NewParseClusterIDFunction(), types.StringValue(tc.id))
+			if (resp.Error != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			if !tc.wantErr && !resp.Result.Value().Equal(tc.want) {
+				t.Errorf("got %s, want %s", resp.Result.Value(), tc.want)
+			}
+		})
+	}
+}
+
+func TestBlueprintVersionCompareFunction(t *testing.T) {
+	cases := []struct {
+		a, b    string
+		want    int64
+		wantErr bool
+	}{
+		{a: "v0", b: "v1", want: -1},
+		{a: "4.1.0", b: "4.1", want: 0},
+		{a: "v1.10.0", b: "v1.9.3", want: 1},
+		{a: "latest", b: "v99", want: 1},
+		{a: "v99", b: "latest", want: -1},
+		{a: "latest", b: "Latest", want: 0},
+		{a: "next", b: "v1", wantErr: true},
+		{a: "v1", b: "", wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.a+" "+tc.b, func(t *testing.T) {
+			resp := runFunction(t, NewBlueprintVersionCompareFunction(), types.StringValue(tc.a), types.StringValue(tc.b))
+			if (resp.Error != nil) != tc.wantErr {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+			if !tc.wantErr && !resp.Result.Value().Equal(types.Int64Value(tc.want)) {
+				t.Errorf("got %s, want %d", resp.Result.Value(), tc.want)
+			}
+		})
+	}
+}
+
+func TestKubeconfigDecodeFunction(t *testing.T) {
+	const kubeconfig = `
+apiVersion: v1
+kind: Config
+current-context: c1
+clusters:
+- name: c1
+  cluster:
+    server: https://c1.example.com
+    certificate-authority-data: Y2Ex
+- name: c2
+  cluster:
+    server: https://c2.example.com
+    certificate-authority-data: Y2Ey
+contexts:
+- name: c1
+  context:
+    cluster: c1
+    user: u1
+- name: c2
+  context:
+    cluster: c2
+    user: u1
+users:
+- name: u1
+  user:
+    token: t1
+`
+	for cluster, host := range map[string]string{"": "https://c1.example.com", "c2": "https://c2.example.com"} {
+		args := []attr.Value{types.StringValue(kubeconfig)}
+		if cluster != "" {
+			args = append(args, types.StringValue(cluster))
+		}
+		resp := runFunction(t, NewKubeconfigDecodeFunction(), args[0], types.TupleValueMust(tupleTypes(args[1:]), args[1:]))
+		if resp.Error != nil {
+			t.Fatalf("cluster %q: %v", cluster, resp.Error)
+		}
+		got := resp.Result.Value().(types.Object).Attributes()
+		if !got["host"].Equal(types.StringValue(host)) || !got["token"].Equal(types.StringValue("t1")) {
+			t.Errorf("cluster %q: got %v", cluster, got)
+		}
+	}
+
+	resp := runFunction(t, NewKubeconfigDecodeFunction(), types.StringValue("contexts: []"), types.TupleValueMust(nil, nil))
+	if resp.Error == nil {
+		t.Error("expected an error for a kubeconfig without contexts")
+	}
+}
+
+func TestDecodeClusterSpecYaml(t *testing.T) {
+	const cluster = `
+kind: Cluster
+metadata:
+  name: c1
+  project: defaultproject
+spec:
+  type: aws-eks
+`
+	const clusterConfig = `
+apiVersion: rafay.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: c1
+  region: us-west-2
+`
+	clusterSpec, clusterConfigSpec, err := decodeClusterSpecYaml(cluster + "---" + clusterConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clusterSpec.Metadata.Name != "c1" || clusterConfigSpec.Metadata.Region != "us-west-2" {
+		t.Errorf("unexpected specs %+v %+v", clusterSpec, clusterConfigSpec)
+	}
+
+	resp := runFunction(t, NewYamlToClusterSpecFunction(), types.StringValue(cluster+"---"+clusterConfig))
+	if resp.Error != nil {
+		t.Fatal(resp.Error)
+	}
+	got := resp.Result.Value().(types.Object).Attributes()
+	if len(got["cluster"].(types.List).Elements()) != 1 || len(got["cluster_config"].(types.List).Elements()) != 1 {
+		t.Errorf("unexpected cluster spec %s", resp.Result.Value())
+	}
+
+	for name, in := range map[string]string{
+		"missing config": cluster,
+		"swapped":        clusterConfig + "---" + cluster,
+	} {
+		if _, _, err := decodeClusterSpecYaml(in); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func runFunction(t *testing.T, f function.Function, args ...attr.Value) *function.RunResponse {
+	t.Helper()
+	ctx := context.Background()
+	var def function.DefinitionResponse
+	f.Definition(ctx, function.DefinitionRequest{}, &def)
+	result, funcErr := def.Definition.Return.NewResultData(ctx)
+	if funcErr != nil {
+		t.Fatal(funcErr)
+	}
+	resp := &function.RunResponse{Result: result}
+	f.Run(ctx, function.RunRequest{Arguments: function.NewArgumentsData(args)}, resp)
+	return resp
+}
+
+func tupleTypes(values []attr.Value) []attr.Type {
+	out := make([]attr.Type, 0, len(values))
+	for _, v := range values {
+		out = append(out, v.Type(context.Background()))
+	}
+	return out
+}
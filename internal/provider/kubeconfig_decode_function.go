@@ -0,0 +1,90 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &KubeconfigDecodeFunction{}
+
+var kubeconfigAttrTypes = map[string]attr.Type{
+	"context":                types.StringType,
+	"host":                   types.StringType,
+	"cluster_ca_certificate": types.StringType,
+	"token":                  types.StringType,
+	"client_certificate":     types.StringType,
+	"client_key":             types.StringType,
+}
+
+func NewKubeconfigDecodeFunction() function.Function {
+	return &KubeconfigDecodeFunction{}
+}
+
+// KubeconfigDecodeFunction pulls the connection fields out of a kubeconfig
+// the way the rafay_kubeconfig ephemeral resource does, for kubeconfigs
+// obtained otherwise, such as from rafay_download_kubeconfig.
+type KubeconfigDecodeFunction struct{}
+
+func (f *KubeconfigDecodeFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "kubeconfig_decode"
+}
+
+func (f *KubeconfigDecodeFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Decode the connection fields of a kubeconfig",
+		Description: "Returns the host, PEM encoded CA certificate and credentials of a kubeconfig context, in the shape of the rafay_kubeconfig ephemeral resource. " +
+			"The context is the one of the given cluster, otherwise the current context, otherwise the first one.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "kubeconfig",
+				Description: "Kubeconfig YAML.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "cluster",
+			Description: "Optional cluster or context name to read the connection fields from.",
+		},
+		Return: function.ObjectReturn{AttributeTypes: kubeconfigAttrTypes},
+	}
+}
+
+func (f *KubeconfigDecodeFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var kubeconfig string
+	var clusters []string
+	resp.Error = req.Arguments.Get(ctx, &kubeconfig, &clusters)
+	if resp.Error != nil {
+		return
+	}
+	if len(clusters) > 1 {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("expected at most one cluster, got %d", len(clusters)))
+		return
+	}
+	var clusterName string
+	if len(clusters) == 1 {
+		clusterName = clusters[0]
+	}
+
+	creds, err := parseKubeconfig(kubeconfig, clusterName)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid kubeconfig: %s", err))
+		return
+	}
+	result, diags := types.ObjectValue(kubeconfigAttrTypes, map[string]attr.Value{
+		"context":                types.StringValue(creds.context),
+		"host":                   types.StringValue(creds.host),
+		"cluster_ca_certificate": types.StringValue(creds.caCertificate),
+		"token":                  types.StringValue(creds.token),
+		"client_certificate":     types.StringValue(creds.clientCertificate),
+		"client_key":             types.StringValue(creds.clientKey),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
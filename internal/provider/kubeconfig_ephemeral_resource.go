@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/go-yaml/yaml"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &KubeconfigEphemeralResource{}
+
+func NewKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &KubeconfigEphemeralResource{}
+}
+
+// KubeconfigEphemeralResource fetches a kubeconfig from the Rafay ZTKA
+// (sentry) endpoint, the same one rafay_download_kubeconfig uses. Unlike that
+// resource the result only ever lives in memory: it is never written to disk
+// and, being ephemeral, never persisted to plan or state.
+type KubeconfigEphemeralResource struct{}
+
+type KubeconfigEphemeralModel struct {
+	Cluster              types.String `tfsdk:"cluster"`
+	Namespace            types.String `tfsdk:"namespace"`
+	Username             types.String `tfsdk:"username"`
+	Kubeconfig           types.String `tfsdk:"kubeconfig"`
+	Context              types.String `tfsdk:"context"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	Token                types.String `tfsdk:"token"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
+
+func (r *KubeconfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubeconfig"
+}
+
+func (r *KubeconfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches a kubeconfig from Rafay without writing it to disk or storing it in state. The parsed connection fields can be passed straight to the kubernetes and helm providers.",
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Optional:    true,
+				Description: "Restrict the kubeconfig to this cluster. Host, CA and credentials are taken from its context.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("username")),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				Optional:    true,
+				Description: "Default namespace to set in the kubeconfig contexts.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("username")),
+				},
+			},
+			"username": schema.StringAttribute{
+				Optional:    true,
+				Description: "Download the kubeconfig of this user instead of the caller. Cannot be combined with cluster or namespace.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The raw kubeconfig YAML.",
+			},
+			"context": schema.StringAttribute{
+				Computed:    true,
+				Description: "Name of the kubeconfig context the connection fields below were read from.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "API server URL of the selected context's cluster.",
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded CA certificate of the selected context's cluster.",
+			},
+			"token": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Bearer token of the selected context's user, if any.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client certificate of the selected context's user, if any.",
+			},
+			"client_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client key of the selected context's user, if any.",
+			},
+		},
+	}
+}
+
+func (r *KubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data KubeconfigEphemeralModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterName := data.Cluster.ValueString()
+	kubeconfig, err := rafay.GetKubeConfig(clusterName, data.Namespace.ValueString(), data.Username.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to fetch kubeconfig, got error: %s", err))
+		return
+	}
+
+	creds, err := parseKubeconfig(kubeconfig, clusterName)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Kubeconfig", fmt.Sprintf("Unable to parse the kubeconfig returned by Rafay, got error: %s", err))
+		return
+	}
+	tflog.Debug(ctx, "fetched kubeconfig", map[string]any{"context": creds.context, "host": creds.host})
+
+	data.Kubeconfig = types.StringValue(kubeconfig)
+	data.Context = types.StringValue(creds.context)
+	data.Host = types.StringValue(creds.host)
+	data.ClusterCACertificate = types.StringValue(creds.caCertificate)
+	data.Token = types.StringValue(creds.token)
+	data.ClientCertificate = types.StringValue(creds.clientCertificate)
+	data.ClientKey = types.StringValue(creds.clientKey)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// kubeconfigFile is the subset of a clientcmd kubeconfig needed to pull out
+// connection details for a single context.
+type kubeconfigFile struct {
+	CurrentContext string `yaml:"current-context"`
+	Clusters       []struct {
+		Name    string `yaml:"name"`
+		Cluster struct {
+			Server                   string `yaml:"server"`
+			CertificateAuthorityData string `yaml:"certificate-authority-data"`
+		} `yaml:"cluster"`
+	} `yaml:"clusters"`
+	Contexts []struct {
+		Name    string `yaml:"name"`
+		Context struct {
+			Cluster string `yaml:"cluster"`
+			User    string `yaml:"user"`
+		} `yaml:"context"`
+	} `yaml:"contexts"`
+	Users []struct {
+		Name string `yaml:"name"`
+		User struct {
+			Token                 string `yaml:"token"`
+			ClientCertificateData string `yaml:"client-certificate-data"`
+			ClientKeyData         string `yaml:"client-key-data"`
+		} `yaml:"user"`
+	} `yaml:"users"`
+}
+
+type kubeconfigCredentials struct {
+	context           string
+	host              string
+	caCertificate     string
+	token             string
+	clientCertificate string
+	clientKey         string
+}
+
+// parseKubeconfig extracts host, CA and user credentials from the context
+// pointing at clusterName, falling back to the current context and then to
+// the first context in the file. The *-data fields are base64 decoded so the
+// values can be handed to other providers as PEM.
+func parseKubeconfig(kubeconfig, clusterName string) (*kubeconfigCredentials, error) {
+	var kc kubeconfigFile
+	if err := yaml.Unmarshal([]byte(kubeconfig), &kc); err != nil {
+		return nil, err
+	}
+	if len(kc.Contexts) == 0 {
+		return nil, fmt.Errorf("kubeconfig has no contexts")
+	}
+
+	ctxIdx := -1
+	if clusterName != "" {
+		for i, c := range kc.Contexts {
+			if c.Name == clusterName || c.Context.Cluster == clusterName {
+				ctxIdx = i
+				break
+			}
+		}
+	}
+	if ctxIdx < 0 {
+		for i, c := range kc.Contexts {
+			if c.Name == kc.CurrentContext {
+				ctxIdx = i
+				break
+			}
+		}
+	}
+	if ctxIdx < 0 {
+		ctxIdx = 0
+	}
+	kctx := kc.Contexts[ctxIdx]
+
+	creds := &kubeconfigCredentials{context: kctx.Name}
+	for _, c := range kc.Clusters {
+		if c.Name != kctx.Context.Cluster {
+			continue
+		}
+		creds.host = c.Cluster.Server
+		ca, err := base64.StdEncoding.DecodeString(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid certificate-authority-data for cluster %q: %w", c.Name, err)
+		}
+		creds.caCertificate = string(ca)
+		break
+	}
+	if creds.host == "" {
+		return nil, fmt.Errorf("context %q references unknown cluster %q", kctx.Name, kctx.Context.Cluster)
+	}
+
+	for _, u := range kc.Users {
+		if u.Name != kctx.Context.User {
+			continue
+		}
+		creds.token = u.User.Token
+		cert, err := base64.StdEncoding.DecodeString(u.User.ClientCertificateData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client-certificate-data for user %q: %w", u.Name, err)
+		}
+		key, err := base64.StdEncoding.DecodeString(u.User.ClientKeyData)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client-key-data for user %q: %w", u.Name, err)
+		}
+		creds.clientCertificate = string(cert)
+		creds.clientKey = string(key)
+		break
+	}
+
+	return creds, nil
+}
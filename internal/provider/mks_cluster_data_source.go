@@ -496,6 +496,15 @@ func MksClusterDataSourceSchema(ctx context.Context) schema.Schema {
 	}
 }
 
+// MksClusterDataSourceModel is fw.MksClusterModel without the resource-only
+// `timeouts` block.
+type MksClusterDataSourceModel struct {
+	ApiVersion types.String     `tfsdk:"api_version"`
+	Kind       types.String     `tfsdk:"kind"`
+	Metadata   fw.MetadataValue `tfsdk:"metadata"`
+	Spec       fw.SpecValue     `tfsdk:"spec"`
+}
+
 // MksClusterDataSource defines the data source implementation of MksClusterResource.
 type MksClusterDataSource struct {
 	client typed.Client
@@ -530,15 +539,23 @@ func (d *MksClusterDataSource) Configure(ctx context.Context, req datasource.Con
 }
 
 func (d *MksClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data fw.MksClusterModel
+	var config MksClusterDataSourceModel
 
 	// Read Terraform configuration data into the model
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	data := fw.MksClusterModel{
+		ApiVersion: config.ApiVersion,
+		Kind:       config.Kind,
+		Metadata:   config.Metadata,
+		Spec:       config.Spec,
+		Timeouts:   fw.NewTimeoutsValueNull(),
+	}
+
 	// Fetch the cluster from the Hub
 	hub, err := d.client.InfraV3().Cluster().Get(ctx, options.GetOptions{
 		Name:    data.Metadata.Name.ValueString(),
@@ -556,5 +573,10 @@ func (d *MksClusterDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	}
 
 	// Save data into Terraform state
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &MksClusterDataSourceModel{
+		ApiVersion: data.ApiVersion,
+		Kind:       data.Kind,
+		Metadata:   data.Metadata,
+		Spec:       data.Spec,
+	})...)
 }
@@ -30,6 +30,13 @@ func NewMksClusterResource() resource.Resource {
 	return &MksClusterResource{}
 }
 
+// Default operation timeouts, used when the `timeouts` block leaves them unset.
+const (
+	defaultMksApplyTimeout  = 90 * time.Minute
+	defaultMksDeleteTimeout = 30 * time.Minute
+	defaultMksReadTimeout   = 10 * time.Minute
+)
+
 // MksClusterResource defines the resource implemSharentation.
 type MksClusterResource struct {
 	client typed.Client
@@ -80,6 +87,12 @@ func (r *MksClusterResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	createTimeout, diags := operationTimeout(data.Timeouts.Create, defaultMksApplyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert the Terraform model to a Hub model
 	hub, daig := fw.ConvertMksClusterToHub(ctx, data)
 	if daig.HasError() {
@@ -95,9 +108,9 @@ func (r *MksClusterResource) Create(ctx context.Context, req resource.CreateRequ
 	}
 
 	// Wait for the cluster operation to complete
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
+	ticker := time.NewTicker(pollInterval(createTimeout))
 	defer ticker.Stop()
-	timeout := time.After(time.Duration(90) * time.Minute)
+	timeout := time.After(createTimeout)
 	daig = fw.WaitForClusterApplyOperation(ctx, r.client, hub, timeout, ticker)
 
 	if daig.HasError() {
@@ -151,6 +164,14 @@ func (r *MksClusterResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	readTimeout, diags := operationTimeout(state.Timeouts.Read, defaultMksReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Read the cluster from the Hub
 	c, err := r.client.InfraV3().Cluster().Get(ctx, options.GetOptions{
 		Name:    state.Metadata.Name.ValueString(),
@@ -205,6 +226,12 @@ func (r *MksClusterResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	updateTimeout, diags := operationTimeout(plan.Timeouts.Update, defaultMksApplyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Convert the Terraform model to a Hub model
 	hub, daigs := fw.ConvertMksClusterToHub(ctx, plan)
 	if daigs.HasError() {
@@ -277,9 +304,9 @@ func (r *MksClusterResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Wait for the cluster operation to complete
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
+	ticker := time.NewTicker(pollInterval(updateTimeout))
 	defer ticker.Stop()
-	timeout := time.After(time.Duration(90) * time.Minute)
+	timeout := time.After(updateTimeout)
 	daigs = fw.WaitForClusterApplyOperation(ctx, r.client, hub, timeout, ticker)
 
 	if daigs.HasError() {
@@ -295,6 +322,15 @@ func (r *MksClusterResource) Delete(ctx context.Context, req resource.DeleteRequ
 	// Read Terraform prior state data into the model
 	var data fw.MksClusterModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := operationTimeout(data.Timeouts.Delete, defaultMksDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	err := r.client.InfraV3().Cluster().Delete(ctx, options.DeleteOptions{
 		Name:    data.Metadata.Name.ValueString(),
@@ -307,10 +343,10 @@ func (r *MksClusterResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Wait for the cluster deletion to be completed
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
+	ticker := time.NewTicker(pollInterval(deleteTimeout))
 	defer ticker.Stop()
 
-	timeout := time.After(time.Duration(30) * time.Minute)
+	timeout := time.After(deleteTimeout)
 	daigs := fw.WaitForClusterDeleteOperation(ctx, r.client, data.Metadata.Name.ValueString(), data.Metadata.Project.ValueString(), timeout, ticker)
 
 	if daigs.HasError() {
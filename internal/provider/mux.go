@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
+
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+)
+
+// NewMuxServer combines this provider with the legacy SDKv2 provider in a
+// single protocol 6 server. Resource type names must be unique across both,
+// so a resource ported here is removed from the SDKv2 ResourcesMap.
+func NewMuxServer(ctx context.Context, version string) (*tf6muxserver.MuxServer, error) {
+	// Upgrade the legacy provider to protocol v6, with support for moved
+	// blocks between its resource types
+	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, rafay.WithMoveState(rafay.New(version)()))
+	if err != nil {
+		return nil, err
+	}
+
+	providers := []func() tfprotov6.ProviderServer{
+		providerserver.NewProtocol6(New(version)()), // terraform-plugin-framework provider
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkServer
+		},
+	}
+
+	return tf6muxserver.NewMuxServer(ctx, providers...)
+}
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fw "github.com/RafaySystems/terraform-provider-rafay/internal/resource_namespace"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResourceWithConfigure = &NamespaceListResource{}
+
+func NewNamespaceListResource() list.ListResource {
+	return &NamespaceListResource{}
+}
+
+// NamespaceListResource lists the namespaces of a project for `terraform
+// query`.
+type NamespaceListResource struct {
+	client typed.Client
+}
+
+func (r *NamespaceListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace"
+}
+
+func (r *NamespaceListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = projectListConfigSchema("namespace")
+}
+
+func (r *NamespaceListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(typed.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected typed.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *NamespaceListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config projectListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	project := config.Project.ValueString()
+	l, err := r.client.InfraV3().Namespace().List(ctx, options.ListOptions{Project: project})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list namespace objects in project %s, got error: %s", project, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	items := l.Items
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].GetMetadata().GetName() < items[j].GetMetadata().GetName()
+	})
+	tflog.Debug(ctx, "listed project resources", map[string]any{"project": project, "kind": "namespace", "count": len(items)})
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, ns := range items {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+			name := ns.GetMetadata().GetName()
+			result := req.NewListResult(ctx)
+			result.DisplayName = name
+			result.Diagnostics.Append(result.Identity.Set(ctx, projectIdentityModel{
+				Name:    types.StringValue(name),
+				Project: types.StringValue(project),
+			})...)
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				var state fw.NamespaceModel
+				result.Diagnostics.Append(fw.ConvertNamespaceFromHub(ctx, ns, &state)...)
+				if !result.Diagnostics.HasError() {
+					result.Diagnostics.Append(result.Resource.Set(ctx, &state)...)
+				}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
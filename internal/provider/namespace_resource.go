@@ -0,0 +1,418 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
+	infrav3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	fw "github.com/RafaySystems/terraform-provider-rafay/internal/resource_namespace"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                 = &NamespaceResource{}
+	_ resource.ResourceWithConfigure    = &NamespaceResource{}
+	_ resource.ResourceWithImportState  = &NamespaceResource{}
+	_ resource.ResourceWithUpgradeState = &NamespaceResource{}
+	_ resource.ResourceWithIdentity     = &NamespaceResource{}
+)
+
+func NewNamespaceResource() resource.Resource {
+	return &NamespaceResource{}
+}
+
+// Default operation timeouts, used when the `timeouts` block leaves them
+// unset. A failed namespace publish takes about 15 minutes to be reported.
+const (
+	defaultNamespaceApplyTimeout  = 17 * time.Minute
+	defaultNamespaceDeleteTimeout = 10 * time.Minute
+
+	// namespaceStatusMaxErrors is how many consecutive errors reading the
+	// publish status are tolerated.
+	namespaceStatusMaxErrors = 5
+)
+
+// NamespaceResource defines the resource implementation.
+type NamespaceResource struct {
+	client typed.Client
+}
+
+func (r *NamespaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace"
+}
+
+func (r *NamespaceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = fw.NamespaceResourceSchema(ctx)
+}
+
+func (r *NamespaceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectIdentitySchema()
+}
+
+func (r *NamespaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(typed.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *typed.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// namespaceClient returns the namespace client of the provider, or of the
+// impersonated user when `impersonate` is set.
+func (r *NamespaceResource) namespaceClient(ctx context.Context, impersonate types.String) (infrav3.NamespaceClient, error) {
+	if impersonate.IsNull() || impersonate.IsUnknown() || impersonate.ValueString() == "" {
+		return r.client.InfraV3().Namespace(), nil
+	}
+	client, err := rafay.ImpersonatedClient(impersonate.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	tflog.Debug(ctx, "impersonating user", map[string]any{"user": impersonate.ValueString()})
+	return client.InfraV3().Namespace(), nil
+}
+
+// namespaceIdentity is the identity of the namespace described by data.
+func namespaceIdentity(data *fw.NamespaceModel) projectIdentityModel {
+	if data.Metadata.IsNull() || data.Metadata.IsUnknown() {
+		return projectIdentityModel{}
+	}
+	return projectIdentityModel{Name: data.Metadata.Name, Project: data.Metadata.Project}
+}
+
+// loadNamespaceArtifacts uploads the content of the `file://` artifacts of
+// ns along with it.
+func loadNamespaceArtifacts(ns *infrapb.Namespace) error {
+	artifactNames, err := ns.ArtifactList()
+	if err != nil {
+		return fmt.Errorf("unable to list artifacts: %w", err)
+	}
+	for _, artifactName := range artifactNames {
+		if !strings.HasPrefix(artifactName, "file://") {
+			continue
+		}
+		artifactPath := filepath.Join(filepath.Dir("."), artifactName[7:])
+		data, err := os.ReadFile(artifactPath)
+		if err != nil {
+			return fmt.Errorf("unable to read artifact at '%s'", artifactPath)
+		}
+		if err := ns.ArtifactSet(artifactName, data); err != nil {
+			return fmt.Errorf("unable to set artifact %s at path '%s'", artifactName, artifactPath)
+		}
+	}
+	return nil
+}
+
+// waitForNamespacePublish polls the publish status of a namespace until it
+// is published, fails, or the timeout runs out. A publish still in progress
+// at the timeout, or only partially ready, is reported as a warning so the
+// namespace is kept in the state.
+func waitForNamespacePublish(ctx context.Context, client infrav3.NamespaceClient, name, project string, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(pollInterval(timeout))
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+	errorCount := 0
+
+	for {
+		select {
+		case <-ctx.Done():
+			diags.AddError("Client Error", fmt.Sprintf("Stopped waiting for namespace %s to publish: %s", name, ctx.Err()))
+			return diags
+
+		case <-deadline:
+			diags.AddWarning("Context deadline reached", fmt.Sprintf("namespace: %s publish may not be complete", name))
+			return diags
+
+		case <-ticker.C:
+			ns, err := client.Status(ctx, options.StatusOptions{
+				Name:    name,
+				Project: project,
+			})
+			if err != nil {
+				errorCount++
+				if errorCount > namespaceStatusMaxErrors {
+					diags.AddError("Client Error", fmt.Sprintf("Unable to read the namespace status, got error: %s", err))
+					return diags
+				}
+				continue
+			}
+			errorCount = 0
+
+			if ns == nil || ns.Status == nil {
+				continue
+			}
+			switch ns.Status.ConditionStatus {
+			case commonpb.ConditionStatus_StatusOK, commonpb.ConditionStatus_StatusNotSet:
+				return diags
+			case commonpb.ConditionStatus_StatusPartiallyReady:
+				diags.AddWarning("Failed to patch namespace", ns.Status.Reason)
+				return diags
+			case commonpb.ConditionStatus_StatusFailed:
+				diags.AddError("Client Error", fmt.Sprintf("Failed to publish namespace %s: %s", name, ns.Status.Reason))
+				return diags
+			}
+			tflog.Info(ctx, "waiting for namespace publish", map[string]any{
+				"namespace": name,
+				"status":    ns.Status.ConditionStatus.String(),
+				"reason":    ns.Status.Reason,
+			})
+		}
+	}
+}
+
+// applyNamespace applies the planned namespace and waits for it to publish.
+func (r *NamespaceResource) applyNamespace(ctx context.Context, client infrav3.NamespaceClient, data *fw.NamespaceModel, timeout time.Duration) diag.Diagnostics {
+	hub, diags := fw.ConvertNamespaceToHub(ctx, *data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := loadNamespaceArtifacts(hub); err != nil {
+		diags.AddError("Client Error", err.Error())
+		return diags
+	}
+
+	if err := client.Apply(ctx, hub, options.ApplyOptions{}); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to apply the namespace, got error: %s", err))
+		return diags
+	}
+
+	diags.Append(waitForNamespacePublish(ctx, client, hub.Metadata.Name, hub.Metadata.Project, timeout)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	applied, err := client.Get(ctx, options.GetOptions{
+		Name:    hub.Metadata.Name,
+		Project: hub.Metadata.Project,
+	})
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read the namespace, got error: %s", err))
+		return diags
+	}
+	diags.Append(fw.ConvertNamespaceComputedFromHub(ctx, applied, data)...)
+	return diags
+}
+
+func (r *NamespaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Read Terraform plan data into the model
+	var data fw.NamespaceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := operationTimeout(data.Timeouts.Create, defaultNamespaceApplyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.namespaceClient(ctx, data.Impersonate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to impersonate the user, got error: %s", err))
+		return
+	}
+
+	name := data.Metadata.Name.ValueString()
+	project := data.Metadata.Project.ValueString()
+	_, err = client.Get(ctx, options.GetOptions{Name: name, Project: project})
+	existed := err == nil
+
+	diags = r.applyNamespace(ctx, client, &data, createTimeout)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		// Don't leave behind a namespace that failed to publish, unless it
+		// was there before.
+		if !existed {
+			tflog.Info(ctx, "namespace create failed, deleting it", map[string]any{"namespace": name})
+			if err := client.Delete(ctx, options.DeleteOptions{Name: name, Project: project}); err != nil {
+				tflog.Warn(ctx, "unable to delete the namespace", map[string]any{"namespace": name, "error": err.Error()})
+			}
+		}
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, namespaceIdentity(&data))...)
+}
+
+func (r *NamespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Read Terraform prior state data into the model
+	var state fw.NamespaceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.namespaceClient(ctx, state.Impersonate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to impersonate the user, got error: %s", err))
+		return
+	}
+
+	ns, err := client.Get(ctx, options.GetOptions{
+		Name:    state.Metadata.Name.ValueString(),
+		Project: state.Metadata.Project.ValueString(),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "code 404") {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the namespace, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(fw.ConvertNamespaceFromHub(ctx, ns, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save the refreshed state into Terraform
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, namespaceIdentity(&state))...)
+}
+
+func (r *NamespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Read Terraform plan data into the model
+	var plan fw.NamespaceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := operationTimeout(plan.Timeouts.Update, defaultNamespaceApplyTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := r.namespaceClient(ctx, plan.Impersonate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to impersonate the user, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.applyNamespace(ctx, client, &plan, updateTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.Identity.Set(ctx, namespaceIdentity(&plan))...)
+}
+
+func (r *NamespaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Read Terraform prior state data into the model
+	var data fw.NamespaceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := operationTimeout(data.Timeouts.Delete, defaultNamespaceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	client, err := r.namespaceClient(ctx, data.Impersonate)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to impersonate the user, got error: %s", err))
+		return
+	}
+
+	err = client.Delete(ctx, options.DeleteOptions{
+		Name:    data.Metadata.Name.ValueString(),
+		Project: data.Metadata.Project.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete the namespace, got error: %s", err))
+		return
+	}
+}
+
+func (r *NamespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var name, project string
+	if req.ID == "" {
+		// Imported by identity
+		var identity projectIdentityModel
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		name, project = identity.Name.ValueString(), identity.Project.ValueString()
+	} else {
+		idParts := strings.Split(req.ID, "/")
+
+		if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+			resp.Diagnostics.AddError(
+				"Unexpected Import Identifier",
+				fmt.Sprintf("Expected import identifier with format: name/project. Got: %q", req.ID),
+			)
+			return
+		}
+
+		name = idParts[0]
+		project = idParts[1]
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("metadata").AtName("project"), project)...)
+}
+
+// UpgradeState upgrades the state of the SDKv2 rafay_namespace, whose
+// nested objects were list-of-one blocks.
+func (r *NamespaceResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	upgrader := resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			if req.RawState == nil || req.RawState.JSON == nil {
+				resp.Diagnostics.AddError("Unable to Upgrade Resource State", "The rafay_namespace state is not JSON.")
+				return
+			}
+			data, diags := fw.UpgradeNamespaceStateV1(ctx, req.RawState.JSON)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		},
+	}
+	return map[int64]resource.StateUpgrader{
+		0: upgrader,
+		1: upgrader,
+	}
+}
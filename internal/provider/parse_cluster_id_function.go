@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseClusterIDFunction{}
+
+var clusterIDAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"project": types.StringType,
+}
+
+func NewParseClusterIDFunction() function.Function {
+	return &ParseClusterIDFunction{}
+}
+
+// ParseClusterIDFunction splits a cluster ID of the name/project form the
+// cluster resources are imported with.
+type ParseClusterIDFunction struct{}
+
+func (f *ParseClusterIDFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_cluster_id"
+}
+
+func (f *ParseClusterIDFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Split a cluster ID into its name and project",
+		Description: "Splits a cluster ID of the form `name/project`, the form cluster resources are imported with, into an object with `name` and `project` attributes.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "id",
+				Description: "Cluster ID of the form `name/project`.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: clusterIDAttrTypes},
+	}
+}
+
+func (f *ParseClusterIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+	resp.Error = req.Arguments.Get(ctx, &id)
+	if resp.Error != nil {
+		return
+	}
+
+	name, project, err := parseClusterID(id)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	result, diags := types.ObjectValue(clusterIDAttrTypes, map[string]attr.Value{
+		"name":    types.StringValue(name),
+		"project": types.StringValue(project),
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+func parseClusterID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("expected a cluster ID of the form name/project, got %q", id)
+	}
+	return idParts[0], idParts[1], nil
+}
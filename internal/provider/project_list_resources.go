@@ -0,0 +1,363 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	sdkdiag "github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ list.ListResourceWithConfigure    = &sdkListResource{}
+	_ list.ListResourceWithRawV6Schemas = &sdkListResource{}
+)
+
+// projectListConfigModel is the `config` block of the list resources of
+// project scoped objects.
+type projectListConfigModel struct {
+	Project types.String `tfsdk:"project"`
+}
+
+// projectIdentityModel is the identity of a project scoped object, see
+// projectIdentitySchema.
+type projectIdentityModel struct {
+	Name    types.String `tfsdk:"name"`
+	Project types.String `tfsdk:"project"`
+}
+
+func projectListConfigSchema(kind string) listschema.Schema {
+	return listschema.Schema{
+		Description: fmt.Sprintf("Lists the %s objects of a project.", kind),
+		Attributes: map[string]listschema.Attribute{
+			"project": listschema.StringAttribute{
+				Required:    true,
+				Description: "Name of the project to list.",
+			},
+		},
+	}
+}
+
+// projectIdentitySchema is the identity of the framework resources of
+// project scoped objects. It matches the identity of the SDK resources, see
+// rafay.withProjectIdentity.
+func projectIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"name": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Name of the object",
+			},
+			"project": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "Project of the object",
+			},
+		},
+	}
+}
+
+// projectResourceKind maps a kind of project scoped object to the resource type
+// that manages it and the hub list call returning the names of its objects.
+type projectResourceKind struct {
+	kind         string
+	resourceType string
+	list         func(ctx context.Context, client typed.Client, project string) ([]string, error)
+}
+
+var projectResourceKinds = []projectResourceKind{
+	{
+		kind:         "blueprint",
+		resourceType: "rafay_blueprint",
+		list: func(ctx context.Context, client typed.Client, project string) ([]string, error) {
+			l, err := client.InfraV3().Blueprint().List(ctx, options.ListOptions{Project: project})
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(l.Items))
+			for _, i := range l.Items {
+				names = append(names, i.Metadata.Name)
+			}
+			return names, nil
+		},
+	},
+	{
+		kind:         "addon",
+		resourceType: "rafay_addon",
+		list: func(ctx context.Context, client typed.Client, project string) ([]string, error) {
+			l, err := client.InfraV3().Addon().List(ctx, options.ListOptions{Project: project})
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(l.Items))
+			for _, i := range l.Items {
+				names = append(names, i.Metadata.Name)
+			}
+			return names, nil
+		},
+	},
+	{
+		kind:         "namespace",
+		resourceType: "rafay_namespace",
+		list: func(ctx context.Context, client typed.Client, project string) ([]string, error) {
+			l, err := client.InfraV3().Namespace().List(ctx, options.ListOptions{Project: project})
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(l.Items))
+			for _, i := range l.Items {
+				names = append(names, i.Metadata.Name)
+			}
+			return names, nil
+		},
+	},
+	{
+		kind:         "workload",
+		resourceType: "rafay_workload",
+		list: func(ctx context.Context, client typed.Client, project string) ([]string, error) {
+			l, err := client.AppsV3().Workload().List(ctx, options.ListOptions{Project: project})
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(l.Items))
+			for _, i := range l.Items {
+				names = append(names, i.Metadata.Name)
+			}
+			return names, nil
+		},
+	},
+	{
+		kind:         "repository",
+		resourceType: "rafay_repositories",
+		list: func(ctx context.Context, client typed.Client, project string) ([]string, error) {
+			l, err := client.IntegrationsV3().Repository().List(ctx, options.ListOptions{Project: project})
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(l.Items))
+			for _, i := range l.Items {
+				names = append(names, i.Metadata.Name)
+			}
+			return names, nil
+		},
+	},
+	{
+		kind:         "credentials",
+		resourceType: "rafay_cloud_credentials_v3",
+		list: func(ctx context.Context, client typed.Client, project string) ([]string, error) {
+			l, err := client.InfraV3().Credentials().List(ctx, options.ListOptions{Project: project})
+			if err != nil {
+				return nil, err
+			}
+			names := make([]string, 0, len(l.Items))
+			for _, i := range l.Items {
+				names = append(names, i.Metadata.Name)
+			}
+			return names, nil
+		},
+	},
+}
+
+// projectListResources returns a list resource for every kind of
+// projectResourceKinds, for `terraform query`. Namespaces are served by the
+// framework resource, the other kinds by SDK resources.
+func projectListResources() []func() list.ListResource {
+	listResources := make([]func() list.ListResource, 0, len(projectResourceKinds))
+	for _, k := range projectResourceKinds {
+		if k.resourceType == "rafay_namespace" {
+			listResources = append(listResources, NewNamespaceListResource)
+			continue
+		}
+		listResources = append(listResources, func() list.ListResource {
+			return &sdkListResource{kind: k}
+		})
+	}
+	return listResources
+}
+
+// sdkResources are the resources of the SDK provider. The list resources
+// of their types read their schemas and the listed objects through them.
+var sdkResources = sync.OnceValue(func() map[string]*sdkschema.Resource {
+	return rafay.New("")().ResourcesMap
+})
+
+// sdkListResource lists the objects of a kind whose resource type is served
+// by the SDK provider.
+type sdkListResource struct {
+	kind   projectResourceKind
+	client typed.Client
+}
+
+func (r *sdkListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = r.kind.resourceType
+}
+
+func (r *sdkListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = projectListConfigSchema(r.kind.kind)
+}
+
+func (r *sdkListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(typed.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected typed.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// RawV6Schemas returns the schemas of the SDK resource as the mux server
+// serves them, upgraded to protocol 6.
+func (r *sdkListResource) RawV6Schemas(ctx context.Context, req list.RawV6SchemaRequest, resp *list.RawV6SchemaResponse) {
+	res, ok := sdkResources()[r.kind.resourceType]
+	if !ok {
+		return
+	}
+	p := &sdkschema.Provider{
+		ResourcesMap: map[string]*sdkschema.Resource{r.kind.resourceType: res},
+	}
+	server, err := tf5to6server.UpgradeServer(ctx, p.GRPCProvider)
+	if err != nil {
+		tflog.Error(ctx, "unable to upgrade the SDK resource schema", map[string]any{"type": r.kind.resourceType, "error": err.Error()})
+		return
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		tflog.Error(ctx, "unable to get the SDK resource schema", map[string]any{"type": r.kind.resourceType, "error": err.Error()})
+		return
+	}
+	identities, err := server.GetResourceIdentitySchemas(ctx, &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		tflog.Error(ctx, "unable to get the SDK resource identity schema", map[string]any{"type": r.kind.resourceType, "error": err.Error()})
+		return
+	}
+	resp.ProtoV6Schema = schemas.ResourceSchemas[r.kind.resourceType]
+	resp.ProtoV6IdentitySchema = identities.IdentitySchemas[r.kind.resourceType]
+}
+
+func (r *sdkListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config projectListConfigModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	res, ok := sdkResources()[r.kind.resourceType]
+	if !ok {
+		diags.AddError("Unknown Resource Type", fmt.Sprintf("The SDK provider has no %s resource. Please report this issue to the provider developers.", r.kind.resourceType))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	project := config.Project.ValueString()
+	names, err := r.kind.list(ctx, r.client, project)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list %s objects in project %s, got error: %s", r.kind.kind, project, err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+	sort.Strings(names)
+	tflog.Debug(ctx, "listed project resources", map[string]any{"project": project, "kind": r.kind.kind, "count": len(names)})
+
+	meta := rafay.ProviderMetaWithClient(r.client)
+	stream.Results = func(push func(list.ListResult) bool) {
+		var pushed int64
+		for _, name := range names {
+			if req.Limit > 0 && pushed >= req.Limit {
+				return
+			}
+			result, found := sdkListResult(ctx, req, res, meta, name, project)
+			if !found {
+				continue
+			}
+			if !push(result) {
+				return
+			}
+			pushed++
+		}
+	}
+}
+
+// sdkListResult returns the result for the object name in project: its
+// identity and, when the request includes the resource, its state as read
+// by the SDK resource res, the way an import of the object would. It
+// reports false when the object was deleted since it was listed.
+func sdkListResult(ctx context.Context, req list.ListRequest, res *sdkschema.Resource, meta any, name, project string) (list.ListResult, bool) {
+	result := req.NewListResult(ctx)
+	result.DisplayName = name
+
+	d := res.Data(nil)
+	d.SetId(name)
+	if err := d.Set("metadata", []any{map[string]any{"name": name, "project": project}}); err != nil {
+		result.Diagnostics.AddError("Unable to set the metadata", err.Error())
+		return result, true
+	}
+	identity, err := d.Identity()
+	if err == nil {
+		err = identity.Set("name", name)
+	}
+	if err == nil {
+		err = identity.Set("project", project)
+	}
+	if err != nil {
+		result.Diagnostics.AddError("Unable to set the identity", err.Error())
+		return result, true
+	}
+
+	if req.IncludeResource {
+		result.Diagnostics.Append(fromSDKDiagnostics(res.ReadContext(ctx, d, meta))...)
+		if result.Diagnostics.HasError() {
+			return result, true
+		}
+		if d.Id() == "" {
+			return result, false
+		}
+		state, err := d.TfTypeResourceState()
+		if err != nil {
+			result.Diagnostics.AddError("Unable to convert the state", err.Error())
+			return result, true
+		}
+		result.Resource.Raw = *state
+	}
+
+	identityState, err := d.TfTypeIdentityState()
+	if err != nil {
+		result.Diagnostics.AddError("Unable to convert the identity", err.Error())
+		return result, true
+	}
+	result.Identity.Raw = *identityState
+	return result, true
+}
+
+func fromSDKDiagnostics(in sdkdiag.Diagnostics) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, d := range in {
+		if d.Severity == sdkdiag.Error {
+			diags.AddError(d.Summary, d.Detail)
+		} else {
+			diags.AddWarning(d.Summary, d.Detail)
+		}
+	}
+	return diags
+}
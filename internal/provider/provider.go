@@ -2,8 +2,6 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
-	"net/http"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -11,6 +9,9 @@ import (
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,13 +22,19 @@ import (
 	rctlcontext "github.com/RafaySystems/rctl/pkg/context"
 
 	"github.com/RafaySystems/rctl/pkg/versioninfo"
+	"github.com/RafaySystems/terraform-provider-rafay/internal/transport"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure RafayFwProvider satisfies terraform framework provider interfaces.
-var _ provider.Provider = &RafayFwProvider{}
+var (
+	_ provider.Provider                       = &RafayFwProvider{}
+	_ provider.ProviderWithEphemeralResources = &RafayFwProvider{}
+	_ provider.ProviderWithFunctions          = &RafayFwProvider{}
+	_ provider.ProviderWithListResources      = &RafayFwProvider{}
+)
 
 const TF_USER_AGENT = "terraform"
 
@@ -50,6 +57,10 @@ type RafayFwProviderModel struct {
 	ApiKey                 types.String        `tfsdk:"api_key"`
 	RestEndpoint           types.String        `tfsdk:"rest_endpoint"`
 	Project                types.String        `tfsdk:"project"`
+	MaxRetries             types.Int64         `tfsdk:"max_retries"`
+	RetryMaxWait           types.String        `tfsdk:"retry_max_wait"`
+	RequestsPerSecond      types.Float64       `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests  types.Int64         `tfsdk:"max_concurrent_requests"`
 }
 
 func (p *RafayFwProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
@@ -74,6 +85,22 @@ func (p *RafayFwProvider) Schema(ctx context.Context, req provider.SchemaRequest
 				Description: "Rafay project",
 				Optional:    true,
 			},
+			"max_retries": schema.Int64Attribute{
+				Description: "Maximum number of retries of a Rafay API request failing with a throttling, server or connection error. Requests that create or trigger something are only retried when the console refused them",
+				Optional:    true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Description: "Maximum wait before retrying a failed Rafay API request, e.g. 30s",
+				Optional:    true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Description: "Maximum sustained rate of Rafay API requests, shared by all resources. 0 means unlimited",
+				Optional:    true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Description: "Maximum number of Rafay API requests in flight at once. 0 means unlimited",
+				Optional:    true,
+			},
 		},
 	}
 
@@ -157,12 +184,44 @@ func (p *RafayFwProvider) Configure(ctx context.Context, req provider.ConfigureR
 		return
 	}
 
+	// -1 and nil tell an unset setting apart from an explicit 0
+	maxRetries := int64(-1)
+	var requestsPerSecond *float64
+	var maxConcurrent *int
+	if !data.MaxRetries.IsNull() && !data.MaxRetries.IsUnknown() {
+		maxRetries = data.MaxRetries.ValueInt64()
+	}
+	if !data.RequestsPerSecond.IsNull() && !data.RequestsPerSecond.IsUnknown() {
+		requestsPerSecond = data.RequestsPerSecond.ValueFloat64Pointer()
+	}
+	if !data.MaxConcurrentRequests.IsNull() && !data.MaxConcurrentRequests.IsUnknown() {
+		v := int(data.MaxConcurrentRequests.ValueInt64())
+		maxConcurrent = &v
+	}
+	retryPolicy, err := transport.PolicyFromConfig(int(maxRetries), data.RetryMaxWait.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid retry configuration", err.Error())
+		return
+	}
+	limits, err := transport.LimitsFromConfig(requestsPerSecond, maxConcurrent)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid rate limit configuration", err.Error())
+		return
+	}
+	transport.Configure(retryPolicy)
+	transport.ConfigureLimits(limits)
+
 	if ignoreTlsError.ValueBool() {
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		if err := transport.SetInsecureSkipVerify(true); err != nil {
+			resp.Diagnostics.AddError("Unable to skip TLS verification", err.Error())
+			return
+		}
 	}
 
 	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(),
+		options.WithInsecureSkipVerify(auth.SkipServerCertValid),
+		options.WithTransport(transport.ForHub(auth.SkipServerCertValid)))
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to initialise the Client, Error", err.Error())
 		return
@@ -170,6 +229,8 @@ func (p *RafayFwProvider) Configure(ctx context.Context, req provider.ConfigureR
 	// Save the client in the provider data
 	resp.ResourceData = client
 	resp.DataSourceData = client
+	resp.EphemeralResourceData = client
+	resp.ListResourceData = client
 
 }
 
@@ -186,6 +247,26 @@ func (p *RafayFwProvider) Resources(ctx context.Context) []func() resource.Resou
 		NewMksClusterResource,
 		NewEksClusterResource,
 		NewBlueprintSyncResource,
+		NewNamespaceResource,
+	}
+}
+
+func (p *RafayFwProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return projectListResources()
+}
+
+func (p *RafayFwProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewKubeconfigEphemeralResource,
+	}
+}
+
+func (p *RafayFwProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseClusterIDFunction,
+		NewKubeconfigDecodeFunction,
+		NewBlueprintVersionCompareFunction,
+		NewYamlToClusterSpecFunction,
 	}
 }
 
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	minPollInterval = 10 * time.Second
+	maxPollInterval = 60 * time.Second
+)
+
+// operationTimeout returns the duration configured in a `timeouts` block
+// attribute, or def when the attribute is not set.
+func operationTimeout(v types.String, def time.Duration) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if v.IsNull() || v.IsUnknown() || v.ValueString() == "" {
+		return def, diags
+	}
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Timeout Cannot Be Parsed",
+			fmt.Sprintf("timeout %q cannot be parsed, %s", v.ValueString(), err),
+		)
+		return 0, diags
+	}
+	if d <= 0 {
+		diags.AddError(
+			"Invalid Timeout",
+			fmt.Sprintf("timeout %q must be a positive duration", v.ValueString()),
+		)
+		return 0, diags
+	}
+	return d, diags
+}
+
+// pollInterval derives how often to poll an operation's status from its
+// timeout, so short timeouts fail fast and long ones don't hammer the API.
+// The interval is a sixtieth of the timeout, kept within 10s and 60s.
+func pollInterval(timeout time.Duration) time.Duration {
+	interval := timeout / 60
+	if interval < minPollInterval {
+		return minPollInterval
+	}
+	if interval > maxPollInterval {
+		return maxPollInterval
+	}
+	return interval
+}
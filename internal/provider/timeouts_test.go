@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOperationTimeout(t *testing.T) {
+	cases := []struct {
+		name    string
+		value   types.String
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "null uses default", value: types.StringNull(), want: time.Hour},
+		{name: "unknown uses default", value: types.StringUnknown(), want: time.Hour},
+		{name: "configured", value: types.StringValue("2h30m"), want: 150 * time.Minute},
+		{name: "unparsable", value: types.StringValue("soon"), wantErr: true},
+		{name: "negative", value: types.StringValue("-5m"), wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, diags := operationTimeout(tc.value, time.Hour)
+			if diags.HasError() != tc.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if !tc.wantErr && got != tc.want {
+				t.Errorf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestPollInterval(t *testing.T) {
+	cases := map[time.Duration]time.Duration{
+		5 * time.Minute:  10 * time.Second,
+		30 * time.Minute: 30 * time.Second,
+		90 * time.Minute: 60 * time.Second,
+		6 * time.Hour:    60 * time.Second,
+	}
+	for timeout, want := range cases {
+		if got := pollInterval(timeout); got != want {
+			t.Errorf("pollInterval(%s) = %s, want %s", timeout, got, want)
+		}
+	}
+}
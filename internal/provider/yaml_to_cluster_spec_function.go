@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-yaml/yaml"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/RafaySystems/terraform-provider-rafay/internal/resource_eks_cluster"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &YamlToClusterSpecFunction{}
+
+func NewYamlToClusterSpecFunction() function.Function {
+	return &YamlToClusterSpecFunction{}
+}
+
+// YamlToClusterSpecFunction converts the rctl cluster YAML of a
+// rafay_eks_cluster_spec into the cluster and cluster_config blocks of
+// rafay_eks_cluster, the way the resource reads them back from the backend.
+type YamlToClusterSpecFunction struct{}
+
+func (f *YamlToClusterSpecFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "yaml_to_cluster_spec"
+}
+
+func (f *YamlToClusterSpecFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Convert an rctl EKS cluster YAML into rafay_eks_cluster blocks",
+		Description: "Converts an rctl EKS cluster YAML, a `Cluster` document followed by a `ClusterConfig` document as used by `rafay_eks_cluster_spec`, " +
+			"into an object with the `cluster` and `cluster_config` attributes of `rafay_eks_cluster`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "yaml",
+				Description: "rctl EKS cluster YAML.",
+			},
+		},
+		Return: function.ObjectReturn{AttributeTypes: clusterSpecAttrTypes(ctx)},
+	}
+}
+
+func (f *YamlToClusterSpecFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clusterSpecYaml string
+	resp.Error = req.Arguments.Get(ctx, &clusterSpecYaml)
+	if resp.Error != nil {
+		return
+	}
+
+	clusterSpec, clusterConfigSpec, err := decodeClusterSpecYaml(clusterSpecYaml)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	var data resource_eks_cluster.EksClusterModel
+	diags := resource_eks_cluster.FlattenEksCluster(ctx, clusterSpec, &data)
+	diags.Append(resource_eks_cluster.FlattenEksClusterConfig(ctx, clusterConfigSpec, &data)...)
+	if diags.HasError() {
+		resp.Error = function.FuncErrorFromDiags(ctx, diags)
+		return
+	}
+
+	result, diags := types.ObjectValue(clusterSpecAttrTypes(ctx), map[string]attr.Value{
+		"cluster":        data.Cluster,
+		"cluster_config": data.ClusterConfig,
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+func clusterSpecAttrTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"cluster":        types.ListType{ElemType: resource_eks_cluster.ClusterValue{}.Type(ctx)},
+		"cluster_config": types.ListType{ElemType: resource_eks_cluster.ClusterConfigValue{}.Type(ctx)},
+	}
+}
+
+// decodeClusterSpecYaml decodes the Cluster and ClusterConfig documents of an
+// rctl EKS cluster YAML.
+func decodeClusterSpecYaml(clusterSpecYaml string) (rafay.EKSCluster, rafay.EKSClusterConfig, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader([]byte(clusterSpecYaml)))
+	clusterSpec := rafay.EKSCluster{}
+	if err := decoder.Decode(&clusterSpec); err != nil {
+		return rafay.EKSCluster{}, rafay.EKSClusterConfig{}, fmt.Errorf("unable to decode the cluster spec: %s", err)
+	}
+	clusterConfigSpec := rafay.EKSClusterConfig{}
+	if err := decoder.Decode(&clusterConfigSpec); err != nil {
+		if errors.Is(err, io.EOF) {
+			err = errors.New("expected a ClusterConfig document after the Cluster document")
+		}
+		return rafay.EKSCluster{}, rafay.EKSClusterConfig{}, fmt.Errorf("unable to decode the cluster config spec: %s", err)
+	}
+	if clusterSpec.Kind != "Cluster" {
+		return rafay.EKSCluster{}, rafay.EKSClusterConfig{}, fmt.Errorf("expected the first document to be of kind Cluster, got %q", clusterSpec.Kind)
+	}
+	if clusterConfigSpec.Kind != "ClusterConfig" {
+		return rafay.EKSCluster{}, rafay.EKSClusterConfig{}, fmt.Errorf("expected the second document to be of kind ClusterConfig, got %q", clusterConfigSpec.Kind)
+	}
+	return clusterSpec, clusterConfigSpec, nil
+}
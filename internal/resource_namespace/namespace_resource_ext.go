@@ -0,0 +1,901 @@
+// Contains the conversion methods between the generated Terraform types of
+// rafay_namespace and the Hub namespace.
+//
+// Reading back from the Hub is guided by the prior value: parts of the spec
+// the configuration leaves out are only filled in when there is no spec at
+// all, which is the case after an import.
+
+package resource_namespace
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	namespaceApiVersion = "infra.k8smgmt.io/v3"
+	namespaceKind       = "Namespace"
+)
+
+// namespaceSpecTranspose mirrors the JSON of infrapb.NamespaceSpec, whose
+// artifact is a oneof that is only reachable through its JSON.
+type namespaceSpecTranspose struct {
+	Psp                       *infrapb.NamespacePSP              `json:"psp,omitempty"`
+	Placement                 *commonpb.PlacementSpec            `json:"placement,omitempty"`
+	Drift                     *commonpb.DriftSpec                `json:"drift,omitempty"`
+	NetworkPolicyParams       *infrapb.NetworkPolicyParams       `json:"networkPolicyParams,omitempty"`
+	NamespaceMeshPolicyParams *infrapb.NamespaceMeshPolicyParams `json:"namespaceMeshPolicyParams,omitempty"`
+	ResourceQuotas            *infrapb.NamespaceResourceQuotas   `json:"resourceQuotas,omitempty"`
+	LimitRange                *infrapb.NamespaceLimitRange       `json:"limitRange,omitempty"`
+	Artifact                  *namespaceArtifact                 `json:"artifact,omitempty"`
+}
+
+type namespaceArtifact struct {
+	Repository string        `json:"repository,omitempty"`
+	Revision   string        `json:"revision,omitempty"`
+	Path       *artifactFile `json:"path,omitempty"`
+}
+
+type artifactFile struct {
+	Name      string `json:"name,omitempty"`
+	Sensitive bool   `json:"sensitive,omitempty"`
+}
+
+func getStringValue(v types.String) string {
+	if v.IsNull() || v.IsUnknown() {
+		return ""
+	}
+	return v.ValueString()
+}
+
+func getBoolValue(v types.Bool) bool {
+	if v.IsNull() || v.IsUnknown() {
+		return false
+	}
+	return v.ValueBool()
+}
+
+func getFloat64Value(v types.Float64) float64 {
+	if v.IsNull() || v.IsUnknown() {
+		return 0
+	}
+	return v.ValueFloat64()
+}
+
+func convertFromTfMap(v types.Map) map[string]string {
+	if v.IsNull() || v.IsUnknown() || len(v.Elements()) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(v.Elements()))
+	for k, e := range v.Elements() {
+		if s, ok := e.(types.String); ok {
+			result[k] = getStringValue(s)
+		}
+	}
+	return result
+}
+
+// normalizeQuantity returns the canonical form of a resource quantity, or v
+// as is when it cannot be parsed.
+func normalizeQuantity(v types.String) string {
+	s := getStringValue(v)
+	if s == "" {
+		return ""
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return s
+	}
+	return q.String()
+}
+
+// objectAttributes returns the attributes of an object value, or nil when it
+// is null or unknown.
+func objectAttributes(ctx context.Context, v attr.Value) (map[string]attr.Value, diag.Diagnostics) {
+	objectValuable, ok := v.(basetypes.ObjectValuable)
+	if !ok {
+		return nil, nil
+	}
+	obj, diags := objectValuable.ToObjectValue(ctx)
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil, diags
+	}
+	return obj.Attributes(), diags
+}
+
+func stringAttribute(attrs map[string]attr.Value, name string) types.String {
+	v, ok := attrs[name].(types.String)
+	if !ok {
+		return types.StringNull()
+	}
+	return v
+}
+
+func float64Attribute(attrs map[string]attr.Value, name string) types.Float64 {
+	v, ok := attrs[name].(types.Float64)
+	if !ok {
+		return types.Float64Null()
+	}
+	return v
+}
+
+func objectAttribute(attrs map[string]attr.Value, name string) basetypes.ObjectValue {
+	v, ok := attrs[name].(basetypes.ObjectValue)
+	if !ok {
+		return basetypes.ObjectValue{}
+	}
+	return v
+}
+
+func (v MetadataValue) ToHub() *commonpb.Metadata {
+	if v.IsNull() || v.IsUnknown() {
+		return &commonpb.Metadata{}
+	}
+	return &commonpb.Metadata{
+		Name:        getStringValue(v.Name),
+		Project:     getStringValue(v.Project),
+		Description: getStringValue(v.Description),
+		Labels:      convertFromTfMap(v.Labels),
+		Annotations: convertFromTfMap(v.Annotations),
+	}
+}
+
+// policyRefsToHub converts the policies of network_policy_params and
+// namespace_mesh_policy_params, whose elements have distinct generated types.
+func policyRefsToHub(ctx context.Context, refs basetypes.ListValue) ([]*commonpb.ResourceNameAndVersionRef, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if refs.IsNull() || refs.IsUnknown() || len(refs.Elements()) == 0 {
+		return nil, diags
+	}
+	out := make([]*commonpb.ResourceNameAndVersionRef, 0, len(refs.Elements()))
+	for _, ref := range refs.Elements() {
+		attrs, d := objectAttributes(ctx, ref)
+		diags.Append(d...)
+		if attrs == nil {
+			continue
+		}
+		out = append(out, &commonpb.ResourceNameAndVersionRef{
+			Name:    getStringValue(stringAttribute(attrs, "name")),
+			Version: getStringValue(stringAttribute(attrs, "version")),
+		})
+	}
+	return out, diags
+}
+
+// The limits of pods and containers have the same attributes but distinct
+// generated types, so they are converted as plain objects.
+
+func resourceQuantityToHub(obj basetypes.ObjectValue) *commonpb.ResourceQuantity {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	attrs := obj.Attributes()
+	return &commonpb.ResourceQuantity{
+		Cpu:    normalizeQuantity(stringAttribute(attrs, "cpu")),
+		Memory: normalizeQuantity(stringAttribute(attrs, "memory")),
+	}
+}
+
+func limitRangeConfigToHub(obj basetypes.ObjectValue) *infrapb.NamespaceLimitRangeConfig {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	attrs := obj.Attributes()
+	hub := &infrapb.NamespaceLimitRangeConfig{
+		Min:            resourceQuantityToHub(objectAttribute(attrs, "min")),
+		Max:            resourceQuantityToHub(objectAttribute(attrs, "max")),
+		Default:        resourceQuantityToHub(objectAttribute(attrs, "default")),
+		DefaultRequest: resourceQuantityToHub(objectAttribute(attrs, "default_request")),
+	}
+	if ratio := objectAttribute(attrs, "ratio"); !ratio.IsNull() && !ratio.IsUnknown() {
+		hub.Ratio = &commonpb.ResourceRatio{
+			Cpu:    float32(getFloat64Value(float64Attribute(ratio.Attributes(), "cpu"))),
+			Memory: float32(getFloat64Value(float64Attribute(ratio.Attributes(), "memory"))),
+		}
+	}
+	return hub
+}
+
+func (v ResourceQuotasValue) ToHub() *infrapb.NamespaceResourceQuotas {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return &infrapb.NamespaceResourceQuotas{
+		ConfigMaps:               getStringValue(v.ConfigMaps),
+		CpuLimits:                getStringValue(v.CpuLimits),
+		CpuRequests:              getStringValue(v.CpuRequests),
+		MemoryLimits:             getStringValue(v.MemoryLimits),
+		MemoryRequests:           getStringValue(v.MemoryRequests),
+		GpuLimits:                getStringValue(v.GpuLimits),
+		GpuRequests:              getStringValue(v.GpuRequests),
+		PersistentVolumeClaims:   getStringValue(v.PersistentVolumeClaims),
+		Pods:                     getStringValue(v.Pods),
+		ReplicationControllers:   getStringValue(v.ReplicationControllers),
+		Secrets:                  getStringValue(v.Secrets),
+		Services:                 getStringValue(v.Services),
+		ServicesLoadBalancers:    getStringValue(v.ServicesLoadBalancers),
+		ServicesNodePorts:        getStringValue(v.ServicesNodePorts),
+		StorageRequests:          getStringValue(v.StorageRequests),
+		EphemeralStorageLimits:   getStringValue(v.EphemeralStorageLimits),
+		EphemeralStorageRequests: getStringValue(v.EphemeralStorageRequests),
+	}
+}
+
+func (v PlacementValue) ToHub(ctx context.Context) (*commonpb.PlacementSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return nil, diags
+	}
+	hub := &commonpb.PlacementSpec{Selector: getStringValue(v.Selector)}
+	if !v.Labels.IsNull() && !v.Labels.IsUnknown() {
+		for _, l := range v.Labels.Elements() {
+			attrs, d := objectAttributes(ctx, l)
+			diags.Append(d...)
+			if attrs == nil {
+				continue
+			}
+			hub.Labels = append(hub.Labels, &commonpb.PlacementLabel{
+				Key:   getStringValue(stringAttribute(attrs, "key")),
+				Value: getStringValue(stringAttribute(attrs, "value")),
+			})
+		}
+	}
+	if !v.Environment.IsNull() && !v.Environment.IsUnknown() {
+		hub.Environment = &commonpb.Environment{
+			Name: getStringValue(stringAttribute(v.Environment.Attributes(), "name")),
+		}
+	}
+	return hub, diags
+}
+
+func (v ArtifactValue) ToHub() *namespaceArtifact {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	artifact := &namespaceArtifact{
+		Repository: getStringValue(v.Repository),
+		Revision:   getStringValue(v.Revision),
+	}
+	if !v.Path.IsNull() && !v.Path.IsUnknown() {
+		attrs := v.Path.Attributes()
+		sensitive, _ := attrs["sensitive"].(types.Bool)
+		artifact.Path = &artifactFile{
+			Name:      getStringValue(stringAttribute(attrs, "name")),
+			Sensitive: getBoolValue(sensitive),
+		}
+	}
+	return artifact
+}
+
+func (v SpecValue) ToHub(ctx context.Context) (*infrapb.NamespaceSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hub := &infrapb.NamespaceSpec{}
+	if v.IsNull() || v.IsUnknown() {
+		return hub, diags
+	}
+
+	nst := namespaceSpecTranspose{}
+
+	if !v.Drift.IsNull() && !v.Drift.IsUnknown() {
+		drift, d := DriftType{}.ValueFromObject(ctx, v.Drift)
+		diags.Append(d...)
+		if drift, ok := drift.(DriftValue); ok {
+			nst.Drift = &commonpb.DriftSpec{
+				Enabled: getBoolValue(drift.Enabled),
+				Action:  getStringValue(drift.Action),
+			}
+		}
+	}
+
+	if !v.Placement.IsNull() && !v.Placement.IsUnknown() {
+		placement, d := PlacementType{}.ValueFromObject(ctx, v.Placement)
+		diags.Append(d...)
+		if placement, ok := placement.(PlacementValue); ok {
+			nst.Placement, d = placement.ToHub(ctx)
+			diags.Append(d...)
+		}
+	}
+
+	if !v.Psp.IsNull() && !v.Psp.IsUnknown() {
+		nst.Psp = &infrapb.NamespacePSP{Name: getStringValue(stringAttribute(v.Psp.Attributes(), "name"))}
+	}
+
+	if !v.ResourceQuotas.IsNull() && !v.ResourceQuotas.IsUnknown() {
+		quotas, d := ResourceQuotasType{}.ValueFromObject(ctx, v.ResourceQuotas)
+		diags.Append(d...)
+		if quotas, ok := quotas.(ResourceQuotasValue); ok {
+			nst.ResourceQuotas = quotas.ToHub()
+		}
+	}
+
+	if !v.LimitRange.IsNull() && !v.LimitRange.IsUnknown() {
+		attrs := v.LimitRange.Attributes()
+		nst.LimitRange = &infrapb.NamespaceLimitRange{
+			Pod:       limitRangeConfigToHub(objectAttribute(attrs, "pod")),
+			Container: limitRangeConfigToHub(objectAttribute(attrs, "container")),
+		}
+	}
+
+	if !v.NetworkPolicyParams.IsNull() && !v.NetworkPolicyParams.IsUnknown() {
+		params, d := NetworkPolicyParamsType{}.ValueFromObject(ctx, v.NetworkPolicyParams)
+		diags.Append(d...)
+		if params, ok := params.(NetworkPolicyParamsValue); ok {
+			policies, d := policyRefsToHub(ctx, params.Policies)
+			diags.Append(d...)
+			nst.NetworkPolicyParams = &infrapb.NetworkPolicyParams{
+				NetworkPolicyEnabled: getBoolValue(params.NetworkPolicyEnabled),
+				Policies:             policies,
+			}
+		}
+	}
+
+	if !v.NamespaceMeshPolicyParams.IsNull() && !v.NamespaceMeshPolicyParams.IsUnknown() {
+		meshParams, d := NamespaceMeshPolicyParamsType{}.ValueFromObject(ctx, v.NamespaceMeshPolicyParams)
+		diags.Append(d...)
+		if meshParams, ok := meshParams.(NamespaceMeshPolicyParamsValue); ok {
+			policies, d := policyRefsToHub(ctx, meshParams.Policies2)
+			diags.Append(d...)
+			nst.NamespaceMeshPolicyParams = &infrapb.NamespaceMeshPolicyParams{
+				MeshEnabled: getBoolValue(meshParams.MeshEnabled),
+				Policies:    policies,
+			}
+		}
+	}
+
+	if !v.Artifact.IsNull() && !v.Artifact.IsUnknown() {
+		artifact, d := ArtifactType{}.ValueFromObject(ctx, v.Artifact)
+		diags.Append(d...)
+		if artifact, ok := artifact.(ArtifactValue); ok {
+			nst.Artifact = artifact.ToHub()
+		}
+	}
+
+	if diags.HasError() {
+		return hub, diags
+	}
+
+	jsonSpec, err := json.Marshal(nst)
+	if err != nil {
+		diags.AddError("Unable to convert the namespace spec", err.Error())
+		return hub, diags
+	}
+	if err := hub.UnmarshalJSON(jsonSpec); err != nil {
+		diags.AddError("Unable to convert the namespace spec", err.Error())
+	}
+	return hub, diags
+}
+
+// ConvertNamespaceToHub converts the Terraform model of a namespace to the
+// Hub namespace to apply.
+func ConvertNamespaceToHub(ctx context.Context, v NamespaceModel) (*infrapb.Namespace, diag.Diagnostics) {
+	hub := &infrapb.Namespace{
+		ApiVersion: namespaceApiVersion,
+		Kind:       namespaceKind,
+		Metadata:   v.Metadata.ToHub(),
+	}
+	spec, diags := v.Spec.ToHub(ctx)
+	hub.Spec = spec
+	return hub, diags
+}
+
+// present reports whether a part of the spec belongs in the value read back
+// from the Hub: when the prior value has it, or when the whole spec is being
+// filled in and the Hub has it.
+func present(prior, hub, full bool) bool {
+	return prior || (full && hub)
+}
+
+func stringValue(hub string) types.String {
+	if hub == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(hub)
+}
+
+// boolValue keeps a prior null for false, which the Hub does not tell apart
+// from unset.
+func boolValue(prior types.Bool, hub bool) types.Bool {
+	if !hub && prior.IsNull() {
+		return prior
+	}
+	return types.BoolValue(hub)
+}
+
+// quantityValue keeps the prior value when the Hub returns the same quantity
+// written differently, e.g. 2Gi for 2048Mi.
+func quantityValue(prior types.String, hub string) types.String {
+	if hub == "" {
+		return types.StringNull()
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		p, perr := resource.ParseQuantity(prior.ValueString())
+		h, herr := resource.ParseQuantity(hub)
+		if perr == nil && herr == nil && p.Cmp(h) == 0 {
+			return prior
+		}
+	}
+	return types.StringValue(hub)
+}
+
+// ratioValue converts a Hub ratio to the shortest float64 that rounds to
+// it, so a configured 1.1 reads back as 1.1.
+func ratioValue(prior types.Float64, hub float32) types.Float64 {
+	if hub == 0 && prior.IsNull() {
+		return prior
+	}
+	if !prior.IsNull() && !prior.IsUnknown() && float32(prior.ValueFloat64()) == hub {
+		return prior
+	}
+	f, err := strconv.ParseFloat(strconv.FormatFloat(float64(hub), 'g', -1, 32), 64)
+	if err != nil {
+		f = float64(hub)
+	}
+	return types.Float64Value(f)
+}
+
+func mapValue(ctx context.Context, prior types.Map, hub map[string]string) (types.Map, diag.Diagnostics) {
+	if len(hub) == 0 {
+		if !prior.IsNull() && !prior.IsUnknown() && len(prior.Elements()) == 0 {
+			return prior, nil
+		}
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, hub)
+}
+
+// Limit range quantities are returned by the Hub scaled down, memory by 1024
+// and CPU by 1000, and are scaled back up as the SDKv2 resource did.
+func limitRangeMemory(prior types.String, hub string) types.String {
+	q, err := resource.ParseQuantity(hub)
+	if hub == "" || err != nil {
+		return quantityValue(prior, hub)
+	}
+	return quantityValue(prior, resource.NewQuantity(q.Value()*1024, resource.BinarySI).String())
+}
+
+func limitRangeCpu(prior types.String, hub string) types.String {
+	q, err := resource.ParseQuantity(hub)
+	if hub == "" || err != nil {
+		return quantityValue(prior, hub)
+	}
+	return quantityValue(prior, resource.NewMilliQuantity(q.ScaledValue(resource.Micro), resource.DecimalSI).String())
+}
+
+func resourceQuantityFromHub(ctx context.Context, prior basetypes.ObjectValue, hub *commonpb.ResourceQuantity, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	attrTypes := MinValue{}.AttributeTypes(ctx)
+	if !present(!prior.IsNull(), hub != nil, full) {
+		return types.ObjectNull(attrTypes), nil
+	}
+	attrs := prior.Attributes()
+	if hub == nil {
+		hub = &commonpb.ResourceQuantity{}
+	}
+	return types.ObjectValue(attrTypes, map[string]attr.Value{
+		"cpu":    limitRangeCpu(stringAttribute(attrs, "cpu"), hub.Cpu),
+		"memory": limitRangeMemory(stringAttribute(attrs, "memory"), hub.Memory),
+	})
+}
+
+func limitRangeConfigFromHub(ctx context.Context, prior basetypes.ObjectValue, hub *infrapb.NamespaceLimitRangeConfig, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	attrTypes := PodValue{}.AttributeTypes(ctx)
+	if !present(!prior.IsNull(), hub != nil, full) {
+		return types.ObjectNull(attrTypes), diags
+	}
+	attrs := prior.Attributes()
+	if hub == nil {
+		hub = &infrapb.NamespaceLimitRangeConfig{}
+	}
+
+	out := make(map[string]attr.Value, len(attrTypes))
+	out["min"], d = resourceQuantityFromHub(ctx, objectAttribute(attrs, "min"), hub.Min, full)
+	diags.Append(d...)
+	out["max"], d = resourceQuantityFromHub(ctx, objectAttribute(attrs, "max"), hub.Max, full)
+	diags.Append(d...)
+	out["default"], d = resourceQuantityFromHub(ctx, objectAttribute(attrs, "default"), hub.Default, full)
+	diags.Append(d...)
+	out["default_request"], d = resourceQuantityFromHub(ctx, objectAttribute(attrs, "default_request"), hub.DefaultRequest, full)
+	diags.Append(d...)
+
+	ratioTypes := RatioValue{}.AttributeTypes(ctx)
+	out["ratio"] = types.ObjectNull(ratioTypes)
+	priorRatio := objectAttribute(attrs, "ratio")
+	if present(!priorRatio.IsNull(), hub.Ratio != nil, full) {
+		hubRatio := hub.Ratio
+		if hubRatio == nil {
+			hubRatio = &commonpb.ResourceRatio{}
+		}
+		out["ratio"], d = types.ObjectValue(ratioTypes, map[string]attr.Value{
+			"cpu":    ratioValue(float64Attribute(priorRatio.Attributes(), "cpu"), hubRatio.Cpu),
+			"memory": ratioValue(float64Attribute(priorRatio.Attributes(), "memory"), hubRatio.Memory),
+		})
+		diags.Append(d...)
+	}
+
+	obj, d := types.ObjectValue(attrTypes, out)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func (v LimitRangeValue) FromHub(ctx context.Context, hub *infrapb.NamespaceLimitRange, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	if !present(!v.IsNull(), hub != nil, full) {
+		return NewLimitRangeValueNull().ToObjectValue(ctx)
+	}
+	if hub == nil {
+		hub = &infrapb.NamespaceLimitRange{}
+	}
+	v.Pod, d = limitRangeConfigFromHub(ctx, v.Pod, hub.Pod, full)
+	diags.Append(d...)
+	v.Container, d = limitRangeConfigFromHub(ctx, v.Container, hub.Container, full)
+	diags.Append(d...)
+
+	v.state = attr.ValueStateKnown
+	obj, d := v.ToObjectValue(ctx)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func (v ResourceQuotasValue) FromHub(ctx context.Context, hub *infrapb.NamespaceResourceQuotas, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	if !present(!v.IsNull(), hub != nil, full) {
+		return NewResourceQuotasValueNull().ToObjectValue(ctx)
+	}
+	if hub == nil {
+		hub = &infrapb.NamespaceResourceQuotas{}
+	}
+	v.ConfigMaps = quantityValue(v.ConfigMaps, hub.ConfigMaps)
+	v.CpuLimits = quantityValue(v.CpuLimits, hub.CpuLimits)
+	v.CpuRequests = quantityValue(v.CpuRequests, hub.CpuRequests)
+	v.MemoryLimits = quantityValue(v.MemoryLimits, hub.MemoryLimits)
+	v.MemoryRequests = quantityValue(v.MemoryRequests, hub.MemoryRequests)
+	v.GpuLimits = quantityValue(v.GpuLimits, hub.GpuLimits)
+	v.GpuRequests = quantityValue(v.GpuRequests, hub.GpuRequests)
+	v.PersistentVolumeClaims = quantityValue(v.PersistentVolumeClaims, hub.PersistentVolumeClaims)
+	v.Pods = quantityValue(v.Pods, hub.Pods)
+	v.ReplicationControllers = quantityValue(v.ReplicationControllers, hub.ReplicationControllers)
+	v.Secrets = quantityValue(v.Secrets, hub.Secrets)
+	v.Services = quantityValue(v.Services, hub.Services)
+	v.ServicesLoadBalancers = quantityValue(v.ServicesLoadBalancers, hub.ServicesLoadBalancers)
+	v.ServicesNodePorts = quantityValue(v.ServicesNodePorts, hub.ServicesNodePorts)
+	v.StorageRequests = quantityValue(v.StorageRequests, hub.StorageRequests)
+	v.EphemeralStorageLimits = quantityValue(v.EphemeralStorageLimits, hub.EphemeralStorageLimits)
+	v.EphemeralStorageRequests = quantityValue(v.EphemeralStorageRequests, hub.EphemeralStorageRequests)
+
+	v.state = attr.ValueStateKnown
+	return v.ToObjectValue(ctx)
+}
+
+// policyRefsFromHub converts Hub policies to a list of elemType, the
+// generated type of the network or the mesh policies.
+func policyRefsFromHub(ctx context.Context, prior basetypes.ListValue, hub []*commonpb.ResourceNameAndVersionRef, full bool, elemType basetypes.ObjectTypable) (basetypes.ListValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(hub) == 0 || (prior.IsNull() && !full) {
+		return types.ListNull(elemType), diags
+	}
+	attrTypes := PoliciesValue{}.AttributeTypes(ctx)
+	elems := make([]attr.Value, 0, len(hub))
+	for _, ref := range hub {
+		obj, d := types.ObjectValue(attrTypes, map[string]attr.Value{
+			"name":    stringValue(ref.Name),
+			"version": stringValue(ref.Version),
+		})
+		diags.Append(d...)
+		elem, d := elemType.ValueFromObject(ctx, obj)
+		diags.Append(d...)
+		elems = append(elems, elem)
+	}
+	if diags.HasError() {
+		return types.ListNull(elemType), diags
+	}
+	list, d := types.ListValue(elemType, elems)
+	diags.Append(d...)
+	return list, diags
+}
+
+func (v NetworkPolicyParamsValue) FromHub(ctx context.Context, hub *infrapb.NetworkPolicyParams, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	if !present(!v.IsNull(), hub != nil, full) {
+		return NewNetworkPolicyParamsValueNull().ToObjectValue(ctx)
+	}
+	if hub == nil {
+		hub = &infrapb.NetworkPolicyParams{}
+	}
+	v.NetworkPolicyEnabled = types.BoolValue(hub.NetworkPolicyEnabled)
+	v.Policies, d = policyRefsFromHub(ctx, v.Policies, hub.Policies, full, PoliciesType{
+		ObjectType: types.ObjectType{AttrTypes: PoliciesValue{}.AttributeTypes(ctx)},
+	})
+	diags.Append(d...)
+
+	v.state = attr.ValueStateKnown
+	obj, d := v.ToObjectValue(ctx)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func (v NamespaceMeshPolicyParamsValue) FromHub(ctx context.Context, hub *infrapb.NamespaceMeshPolicyParams, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	if !present(!v.IsNull(), hub != nil, full) {
+		return NewNamespaceMeshPolicyParamsValueNull().ToObjectValue(ctx)
+	}
+	if hub == nil {
+		hub = &infrapb.NamespaceMeshPolicyParams{}
+	}
+	v.MeshEnabled = types.BoolValue(hub.MeshEnabled)
+	v.Policies2, d = policyRefsFromHub(ctx, v.Policies2, hub.Policies, full, Policies2Type{
+		ObjectType: types.ObjectType{AttrTypes: Policies2Value{}.AttributeTypes(ctx)},
+	})
+	diags.Append(d...)
+
+	v.state = attr.ValueStateKnown
+	obj, d := v.ToObjectValue(ctx)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func (v PlacementValue) FromHub(ctx context.Context, hub *commonpb.PlacementSpec, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	if !present(!v.IsNull(), hub != nil, full) {
+		return NewPlacementValueNull().ToObjectValue(ctx)
+	}
+	if hub == nil {
+		hub = &commonpb.PlacementSpec{}
+	}
+	v.Selector = stringValue(hub.Selector)
+
+	labelsType := LabelsValue{}.Type(ctx)
+	if present(!v.Labels.IsNull(), len(hub.Labels) > 0, full) {
+		labels := make([]attr.Value, 0, len(hub.Labels))
+		for _, l := range hub.Labels {
+			labels = append(labels, LabelsValue{
+				Key:   stringValue(l.Key),
+				Value: stringValue(l.Value),
+				state: attr.ValueStateKnown,
+			})
+		}
+		v.Labels, d = types.ListValue(labelsType, labels)
+		diags.Append(d...)
+	} else {
+		v.Labels = types.ListNull(labelsType)
+	}
+
+	if hub.Environment != nil && (!v.Environment.IsNull() || full) {
+		v.Environment, d = EnvironmentValue{
+			Name:  stringValue(hub.Environment.Name),
+			state: attr.ValueStateKnown,
+		}.ToObjectValue(ctx)
+		diags.Append(d...)
+	} else {
+		v.Environment, d = NewEnvironmentValueNull().ToObjectValue(ctx)
+		diags.Append(d...)
+	}
+
+	v.state = attr.ValueStateKnown
+	obj, d := v.ToObjectValue(ctx)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func (v PspValue) FromHub(ctx context.Context, hub *infrapb.NamespacePSP, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	if !present(!v.IsNull(), hub != nil, full) {
+		return NewPspValueNull().ToObjectValue(ctx)
+	}
+	if hub == nil {
+		hub = &infrapb.NamespacePSP{}
+	}
+	v.Name = stringValue(hub.Name)
+
+	v.state = attr.ValueStateKnown
+	return v.ToObjectValue(ctx)
+}
+
+func (v ArtifactValue) FromHub(ctx context.Context, hub *namespaceArtifact, full bool) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	if !present(!v.IsNull(), hub != nil, full) {
+		return NewArtifactValueNull().ToObjectValue(ctx)
+	}
+	if hub == nil {
+		hub = &namespaceArtifact{}
+	}
+	v.Repository = stringValue(hub.Repository)
+	v.Revision = stringValue(hub.Revision)
+
+	if hub.Path != nil && (!v.Path.IsNull() || full) {
+		v.Path, d = PathValue{
+			Name:      stringValue(hub.Path.Name),
+			Sensitive: types.BoolValue(hub.Path.Sensitive),
+			state:     attr.ValueStateKnown,
+		}.ToObjectValue(ctx)
+		diags.Append(d...)
+	} else {
+		v.Path, d = NewPathValueNull().ToObjectValue(ctx)
+		diags.Append(d...)
+	}
+
+	v.state = attr.ValueStateKnown
+	obj, d := v.ToObjectValue(ctx)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func driftFromHub(ctx context.Context, prior basetypes.ObjectValue, hub *commonpb.DriftSpec) (basetypes.ObjectValue, diag.Diagnostics) {
+	if (prior.IsNull() || prior.IsUnknown()) && hub == nil {
+		return NewDriftValueNull().ToObjectValue(ctx)
+	}
+
+	priorEnabled := types.BoolNull()
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if enabled, ok := prior.Attributes()["enabled"].(types.Bool); ok {
+			priorEnabled = enabled
+		}
+	}
+	if hub == nil {
+		hub = &commonpb.DriftSpec{}
+	}
+
+	return DriftValue{
+		Enabled: boolValue(priorEnabled, hub.Enabled),
+		Action:  stringValue(hub.Action),
+		state:   attr.ValueStateKnown,
+	}.ToObjectValue(ctx)
+}
+
+func transposeSpec(hub *infrapb.NamespaceSpec) (namespaceSpecTranspose, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nst := namespaceSpecTranspose{}
+	if hub == nil {
+		return nst, diags
+	}
+	jsonSpec, err := hub.MarshalJSON()
+	if err != nil {
+		diags.AddError("Unable to convert the namespace spec", err.Error())
+		return nst, diags
+	}
+	if err := json.Unmarshal(jsonSpec, &nst); err != nil {
+		diags.AddError("Unable to convert the namespace spec", err.Error())
+	}
+	return nst, diags
+}
+
+func (v SpecValue) FromHub(ctx context.Context, hub *infrapb.NamespaceSpec, full bool) (SpecValue, diag.Diagnostics) {
+	nst, diags := transposeSpec(hub)
+	if diags.HasError() {
+		return v, diags
+	}
+
+	var d diag.Diagnostics
+	v.Drift, d = driftFromHub(ctx, v.Drift, nst.Drift)
+	diags.Append(d...)
+
+	// The prior value of a part is null when it is left out, in which case
+	// building it from its attributes fails.
+	placement, d := NewPlacementValue(v.Placement.AttributeTypes(ctx), v.Placement.Attributes())
+	if d.HasError() {
+		placement = NewPlacementValueNull()
+	}
+	v.Placement, d = placement.FromHub(ctx, nst.Placement, full)
+	diags.Append(d...)
+
+	psp, d := NewPspValue(v.Psp.AttributeTypes(ctx), v.Psp.Attributes())
+	if d.HasError() {
+		psp = NewPspValueNull()
+	}
+	v.Psp, d = psp.FromHub(ctx, nst.Psp, full)
+	diags.Append(d...)
+
+	quotas, d := NewResourceQuotasValue(v.ResourceQuotas.AttributeTypes(ctx), v.ResourceQuotas.Attributes())
+	if d.HasError() {
+		quotas = NewResourceQuotasValueNull()
+	}
+	v.ResourceQuotas, d = quotas.FromHub(ctx, nst.ResourceQuotas, full)
+	diags.Append(d...)
+
+	limitRange, d := NewLimitRangeValue(v.LimitRange.AttributeTypes(ctx), v.LimitRange.Attributes())
+	if d.HasError() {
+		limitRange = NewLimitRangeValueNull()
+	}
+	v.LimitRange, d = limitRange.FromHub(ctx, nst.LimitRange, full)
+	diags.Append(d...)
+
+	params, d := NewNetworkPolicyParamsValue(v.NetworkPolicyParams.AttributeTypes(ctx), v.NetworkPolicyParams.Attributes())
+	if d.HasError() {
+		params = NewNetworkPolicyParamsValueNull()
+	}
+	v.NetworkPolicyParams, d = params.FromHub(ctx, nst.NetworkPolicyParams, full)
+	diags.Append(d...)
+
+	meshParams, d := NewNamespaceMeshPolicyParamsValue(v.NamespaceMeshPolicyParams.AttributeTypes(ctx), v.NamespaceMeshPolicyParams.Attributes())
+	if d.HasError() {
+		meshParams = NewNamespaceMeshPolicyParamsValueNull()
+	}
+	v.NamespaceMeshPolicyParams, d = meshParams.FromHub(ctx, nst.NamespaceMeshPolicyParams, full)
+	diags.Append(d...)
+
+	artifact, d := NewArtifactValue(v.Artifact.AttributeTypes(ctx), v.Artifact.Attributes())
+	if d.HasError() {
+		artifact = NewArtifactValueNull()
+	}
+	v.Artifact, d = artifact.FromHub(ctx, nst.Artifact, full)
+	diags.Append(d...)
+
+	v.state = attr.ValueStateKnown
+	return v, diags
+}
+
+// ConvertNamespaceFromHub refreshes tf from the Hub namespace. A model
+// without a spec, as left by an import, gets the whole spec from the Hub.
+func ConvertNamespaceFromHub(ctx context.Context, hub *infrapb.Namespace, tf *NamespaceModel) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	if hub == nil || hub.Metadata == nil {
+		diags.AddError("Unable to convert the namespace", "the namespace has no metadata")
+		return diags
+	}
+
+	tf.Id = types.StringValue(hub.Metadata.Name)
+
+	metadata := tf.Metadata
+	metadata.Name = types.StringValue(hub.Metadata.Name)
+	metadata.Project = types.StringValue(hub.Metadata.Project)
+	metadata.Description = stringValue(hub.Metadata.Description)
+	metadata.Labels, d = mapValue(ctx, metadata.Labels, hub.Metadata.Labels)
+	diags.Append(d...)
+	metadata.Annotations, d = mapValue(ctx, metadata.Annotations, hub.Metadata.Annotations)
+	diags.Append(d...)
+	metadata.state = attr.ValueStateKnown
+	tf.Metadata = metadata
+
+	full := tf.Spec.IsNull() || tf.Spec.IsUnknown()
+	if full {
+		tf.Spec = NewSpecValueNull()
+	}
+	tf.Spec, d = tf.Spec.FromHub(ctx, hub.Spec, full)
+	diags.Append(d...)
+
+	if tf.Impersonate.IsUnknown() {
+		tf.Impersonate = types.StringNull()
+	}
+	return diags
+}
+
+// ConvertNamespaceComputedFromHub sets the attributes of tf that are only
+// known once the namespace is applied, and leaves the rest as planned.
+func ConvertNamespaceComputedFromHub(ctx context.Context, hub *infrapb.Namespace, tf *NamespaceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if hub == nil || hub.Metadata == nil {
+		diags.AddError("Unable to convert the namespace", "the namespace has no metadata")
+		return diags
+	}
+	tf.Id = types.StringValue(hub.Metadata.Name)
+
+	if !tf.Spec.IsNull() && tf.Spec.Drift.IsUnknown() {
+		nst, d := transposeSpec(hub.Spec)
+		diags.Append(d...)
+		if d.HasError() {
+			return diags
+		}
+		tf.Spec.Drift, d = driftFromHub(ctx, basetypes.ObjectValue{}, nst.Drift)
+		diags.Append(d...)
+	}
+	return diags
+}
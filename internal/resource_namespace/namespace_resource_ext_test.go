@@ -0,0 +1,157 @@
+package resource_namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// setState writes data to a state of the namespace schema, which fails when
+// a nested value does not have the type of its schema attribute.
+func setState(t *testing.T, data *NamespaceModel) {
+	t.Helper()
+	ctx := context.Background()
+	schema := NamespaceResourceSchema(ctx)
+	state := tfsdk.State{Schema: schema, Raw: tftypes.NewValue(schema.Type().TerraformType(ctx), nil)}
+	if diags := state.Set(ctx, data); diags.HasError() {
+		t.Fatalf("unable to set the state: %v", diags)
+	}
+}
+
+func TestConvertNamespaceRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	model, diags := UpgradeNamespaceStateV1(ctx, []byte(namespaceStateV1JSON))
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	hub, diags := ConvertNamespaceToHub(ctx, model)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	nst, diags := transposeSpec(hub.Spec)
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	if nst.Placement == nil || len(nst.Placement.Labels) != 1 || nst.Placement.Labels[0].Value != "c1" {
+		t.Errorf("placement = %+v, want the c1 label", nst.Placement)
+	}
+	if nst.ResourceQuotas == nil || nst.ResourceQuotas.CpuLimits != "4000m" {
+		t.Errorf("resource_quotas = %+v", nst.ResourceQuotas)
+	}
+	if nst.LimitRange == nil || nst.LimitRange.Pod == nil || nst.LimitRange.Pod.Max.Cpu != "500m" || nst.LimitRange.Container != nil {
+		t.Errorf("limit_range = %+v", nst.LimitRange)
+	}
+	if nst.NetworkPolicyParams == nil || len(nst.NetworkPolicyParams.Policies) != 1 || nst.NetworkPolicyParams.Policies[0].Name != "deny-all" {
+		t.Errorf("network_policy_params = %+v", nst.NetworkPolicyParams)
+	}
+
+	read := model
+	if diags := ConvertNamespaceFromHub(ctx, hub, &read); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	setState(t, &read)
+	if !read.Metadata.Equal(model.Metadata) {
+		t.Errorf("metadata = %s, want %s", read.Metadata, model.Metadata)
+	}
+	// The limit range is left out, the Hub returns it scaled down.
+	parts := map[string][2]basetypes.ObjectValue{
+		"drift":                        {read.Spec.Drift, model.Spec.Drift},
+		"placement":                    {read.Spec.Placement, model.Spec.Placement},
+		"resource_quotas":              {read.Spec.ResourceQuotas, model.Spec.ResourceQuotas},
+		"network_policy_params":        {read.Spec.NetworkPolicyParams, model.Spec.NetworkPolicyParams},
+		"namespace_mesh_policy_params": {read.Spec.NamespaceMeshPolicyParams, model.Spec.NamespaceMeshPolicyParams},
+		"artifact":                     {read.Spec.Artifact, model.Spec.Artifact},
+	}
+	for name, part := range parts {
+		if !part[0].Equal(part[1]) {
+			t.Errorf("%s = %s, want %s", name, part[0], part[1])
+		}
+	}
+}
+
+func TestConvertNamespaceFromHubImport(t *testing.T) {
+	ctx := context.Background()
+
+	hub := &infrapb.Namespace{Metadata: &commonpb.Metadata{Name: "cloudops", Project: "terraform"}}
+	var data NamespaceModel
+	if diags := ConvertNamespaceFromHub(ctx, hub, &data); diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+	setState(t, &data)
+
+	if data.Id.ValueString() != "cloudops" || data.Metadata.Project.ValueString() != "terraform" {
+		t.Errorf("got %s %s, want cloudops in terraform", data.Id, data.Metadata)
+	}
+	if data.Spec.IsNull() || !data.Spec.Placement.IsNull() || !data.Spec.Drift.IsNull() {
+		t.Errorf("spec = %s, want a spec without placement or drift", data.Spec)
+	}
+}
+
+func TestQuantityValue(t *testing.T) {
+	cases := []struct {
+		name  string
+		prior types.String
+		hub   string
+		want  types.String
+	}{
+		{name: "unset", prior: types.StringValue("1Gi"), hub: "", want: types.StringNull()},
+		{name: "same quantity", prior: types.StringValue("2048Mi"), hub: "2Gi", want: types.StringValue("2048Mi")},
+		{name: "changed", prior: types.StringValue("2048Mi"), hub: "1Gi", want: types.StringValue("1Gi")},
+		{name: "no prior", prior: types.StringNull(), hub: "500m", want: types.StringValue("500m")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := quantityValue(tc.prior, tc.hub); !got.Equal(tc.want) {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLimitRangeScaling(t *testing.T) {
+	if got := limitRangeMemory(types.StringNull(), "128Ki"); got.ValueString() != "128Mi" {
+		t.Errorf("memory = %s, want 128Mi", got)
+	}
+	if got := limitRangeMemory(types.StringValue("0.125Gi"), "128Ki"); got.ValueString() != "0.125Gi" {
+		t.Errorf("memory = %s, want the prior 0.125Gi", got)
+	}
+	if got := limitRangeCpu(types.StringNull(), "500u"); got.ValueString() != "500m" {
+		t.Errorf("cpu = %s, want 500m", got)
+	}
+	if got := limitRangeCpu(types.StringNull(), ""); !got.IsNull() {
+		t.Errorf("cpu = %s, want null", got)
+	}
+}
+
+func TestRatioValue(t *testing.T) {
+	if got := ratioValue(types.Float64Null(), 1.1); got.ValueFloat64() != 1.1 {
+		t.Errorf("ratio = %s, want 1.1", got)
+	}
+	if got := ratioValue(types.Float64Null(), 0); !got.IsNull() {
+		t.Errorf("ratio = %s, want null", got)
+	}
+	if got := ratioValue(types.Float64Value(2), 2); got.ValueFloat64() != 2 {
+		t.Errorf("ratio = %s, want 2", got)
+	}
+}
+
+func TestBoolValue(t *testing.T) {
+	if got := boolValue(types.BoolNull(), false); !got.IsNull() {
+		t.Errorf("got %s, want null", got)
+	}
+	if got := boolValue(types.BoolNull(), true); !got.ValueBool() {
+		t.Errorf("got %s, want true", got)
+	}
+	if got := boolValue(types.BoolValue(true), false); got.IsNull() || got.ValueBool() {
+		t.Errorf("got %s, want false", got)
+	}
+}
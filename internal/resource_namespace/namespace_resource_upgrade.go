@@ -0,0 +1,402 @@
+// Contains the upgrade of the state of the SDKv2 rafay_namespace, where
+// every nested object is a list-of-one block, to NamespaceModel.
+
+package resource_namespace
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type namespaceStateV1 struct {
+	ID          string       `json:"id"`
+	Impersonate string       `json:"impersonate"`
+	Metadata    []metadataV1 `json:"metadata"`
+	Spec        []specV1     `json:"spec"`
+	Timeouts    *timeoutsV1  `json:"timeouts"`
+}
+
+type metadataV1 struct {
+	Name        string            `json:"name"`
+	Project     string            `json:"project"`
+	Description string            `json:"description"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+type specV1 struct {
+	Drift                     []driftV1               `json:"drift"`
+	Placement                 []placementV1           `json:"placement"`
+	Psp                       []pspV1                 `json:"psp"`
+	ResourceQuotas            []resourceQuotasV1      `json:"resource_quotas"`
+	LimitRange                []limitRangeV1          `json:"limit_range"`
+	NetworkPolicyParams       []networkPolicyParamsV1 `json:"network_policy_params"`
+	NamespaceMeshPolicyParams []meshPolicyParamsV1    `json:"namespace_mesh_policy_params"`
+	Artifact                  []artifactV1            `json:"artifact"`
+}
+
+type driftV1 struct {
+	Enabled bool   `json:"enabled"`
+	Action  string `json:"action"`
+}
+
+type placementV1 struct {
+	Labels      []placementLabelV1 `json:"labels"`
+	Selector    string             `json:"selector"`
+	Environment []environmentV1    `json:"environment"`
+}
+
+type placementLabelV1 struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type environmentV1 struct {
+	Name string `json:"name"`
+}
+
+type pspV1 struct {
+	Name string `json:"name"`
+}
+
+type resourceQuotasV1 struct {
+	ConfigMaps               string `json:"config_maps"`
+	CpuLimits                string `json:"cpu_limits"`
+	CpuRequests              string `json:"cpu_requests"`
+	MemoryLimits             string `json:"memory_limits"`
+	MemoryRequests           string `json:"memory_requests"`
+	GpuLimits                string `json:"gpu_limits"`
+	GpuRequests              string `json:"gpu_requests"`
+	PersistentVolumeClaims   string `json:"persistent_volume_claims"`
+	Pods                     string `json:"pods"`
+	ReplicationControllers   string `json:"replication_controllers"`
+	Secrets                  string `json:"secrets"`
+	Services                 string `json:"services"`
+	ServicesLoadBalancers    string `json:"services_load_balancers"`
+	ServicesNodePorts        string `json:"services_node_ports"`
+	StorageRequests          string `json:"storage_requests"`
+	EphemeralStorageLimits   string `json:"ephemeral_storage_limits"`
+	EphemeralStorageRequests string `json:"ephemeral_storage_requests"`
+}
+
+type limitRangeV1 struct {
+	Pod       []limitRangeConfigV1 `json:"pod"`
+	Container []limitRangeConfigV1 `json:"container"`
+}
+
+type limitRangeConfigV1 struct {
+	Min            []resourceQuantityV1 `json:"min"`
+	Max            []resourceQuantityV1 `json:"max"`
+	Default        []resourceQuantityV1 `json:"default"`
+	DefaultRequest []resourceQuantityV1 `json:"default_request"`
+	Ratio          []resourceRatioV1    `json:"ratio"`
+}
+
+type resourceQuantityV1 struct {
+	Cpu    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
+
+type resourceRatioV1 struct {
+	Cpu    float64 `json:"cpu"`
+	Memory float64 `json:"memory"`
+}
+
+type networkPolicyParamsV1 struct {
+	NetworkPolicyEnabled bool          `json:"network_policy_enabled"`
+	Policies             []policyRefV1 `json:"policies"`
+}
+
+type meshPolicyParamsV1 struct {
+	MeshEnabled bool          `json:"mesh_enabled"`
+	Policies    []policyRefV1 `json:"policies"`
+}
+
+type policyRefV1 struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type artifactV1 struct {
+	Repository string           `json:"repository"`
+	Revision   string           `json:"revision"`
+	Path       []artifactFileV1 `json:"path"`
+}
+
+type artifactFileV1 struct {
+	Name      string `json:"name"`
+	Sensitive bool   `json:"sensitive"`
+}
+
+type timeoutsV1 struct {
+	Create string `json:"create"`
+	Update string `json:"update"`
+	Delete string `json:"delete"`
+}
+
+func upgradeMap(ctx context.Context, m map[string]string) (types.Map, diag.Diagnostics) {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType), nil
+	}
+	return types.MapValueFrom(ctx, types.StringType, m)
+}
+
+func upgradeFloat(f float64) types.Float64 {
+	if f == 0 {
+		return types.Float64Null()
+	}
+	return types.Float64Value(f)
+}
+
+func upgradePolicyRefs(ctx context.Context, refs []policyRefV1, elemType basetypes.ObjectTypable) (basetypes.ListValue, diag.Diagnostics) {
+	hub := make([]*commonpb.ResourceNameAndVersionRef, 0, len(refs))
+	for _, ref := range refs {
+		hub = append(hub, &commonpb.ResourceNameAndVersionRef{Name: ref.Name, Version: ref.Version})
+	}
+	return policyRefsFromHub(ctx, basetypes.ListValue{}, hub, true, elemType)
+}
+
+func upgradeResourceQuantity(ctx context.Context, q []resourceQuantityV1) (basetypes.ObjectValue, diag.Diagnostics) {
+	attrTypes := MinValue{}.AttributeTypes(ctx)
+	if len(q) == 0 {
+		return types.ObjectNull(attrTypes), nil
+	}
+	return types.ObjectValue(attrTypes, map[string]attr.Value{
+		"cpu":    stringValue(q[0].Cpu),
+		"memory": stringValue(q[0].Memory),
+	})
+}
+
+func upgradeLimitRangeConfig(ctx context.Context, c []limitRangeConfigV1) (basetypes.ObjectValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	attrTypes := PodValue{}.AttributeTypes(ctx)
+	if len(c) == 0 {
+		return types.ObjectNull(attrTypes), diags
+	}
+
+	out := make(map[string]attr.Value, len(attrTypes))
+	out["min"], d = upgradeResourceQuantity(ctx, c[0].Min)
+	diags.Append(d...)
+	out["max"], d = upgradeResourceQuantity(ctx, c[0].Max)
+	diags.Append(d...)
+	out["default"], d = upgradeResourceQuantity(ctx, c[0].Default)
+	diags.Append(d...)
+	out["default_request"], d = upgradeResourceQuantity(ctx, c[0].DefaultRequest)
+	diags.Append(d...)
+
+	ratioTypes := RatioValue{}.AttributeTypes(ctx)
+	out["ratio"] = types.ObjectNull(ratioTypes)
+	if len(c[0].Ratio) > 0 {
+		out["ratio"], d = types.ObjectValue(ratioTypes, map[string]attr.Value{
+			"cpu":    upgradeFloat(c[0].Ratio[0].Cpu),
+			"memory": upgradeFloat(c[0].Ratio[0].Memory),
+		})
+		diags.Append(d...)
+	}
+
+	obj, d := types.ObjectValue(attrTypes, out)
+	diags.Append(d...)
+	return obj, diags
+}
+
+func (s specV1) upgrade(ctx context.Context) (SpecValue, diag.Diagnostics) {
+	var diags, d diag.Diagnostics
+
+	out := SpecValue{state: attr.ValueStateKnown}
+
+	out.Drift, d = NewDriftValueNull().ToObjectValue(ctx)
+	diags.Append(d...)
+	if len(s.Drift) > 0 {
+		out.Drift, d = DriftValue{
+			Enabled: types.BoolValue(s.Drift[0].Enabled),
+			Action:  stringValue(s.Drift[0].Action),
+			state:   attr.ValueStateKnown,
+		}.ToObjectValue(ctx)
+		diags.Append(d...)
+	}
+
+	placement := NewPlacementValueNull()
+	if len(s.Placement) > 0 {
+		p := s.Placement[0]
+		placement = PlacementValue{Selector: stringValue(p.Selector), state: attr.ValueStateKnown}
+		placement.Labels = types.ListNull(LabelsValue{}.Type(ctx))
+		if len(p.Labels) > 0 {
+			labels := make([]attr.Value, 0, len(p.Labels))
+			for _, l := range p.Labels {
+				labels = append(labels, LabelsValue{
+					Key:   stringValue(l.Key),
+					Value: stringValue(l.Value),
+					state: attr.ValueStateKnown,
+				})
+			}
+			placement.Labels, d = types.ListValue(LabelsValue{}.Type(ctx), labels)
+			diags.Append(d...)
+		}
+		if len(p.Environment) > 0 {
+			placement.Environment, d = EnvironmentValue{
+				Name:  stringValue(p.Environment[0].Name),
+				state: attr.ValueStateKnown,
+			}.ToObjectValue(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.Placement, d = placement.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	psp := NewPspValueNull()
+	if len(s.Psp) > 0 {
+		psp = PspValue{Name: stringValue(s.Psp[0].Name), state: attr.ValueStateKnown}
+	}
+	out.Psp, d = psp.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	quotas := NewResourceQuotasValueNull()
+	if len(s.ResourceQuotas) > 0 {
+		q := s.ResourceQuotas[0]
+		quotas = ResourceQuotasValue{
+			ConfigMaps:               stringValue(q.ConfigMaps),
+			CpuLimits:                stringValue(q.CpuLimits),
+			CpuRequests:              stringValue(q.CpuRequests),
+			MemoryLimits:             stringValue(q.MemoryLimits),
+			MemoryRequests:           stringValue(q.MemoryRequests),
+			GpuLimits:                stringValue(q.GpuLimits),
+			GpuRequests:              stringValue(q.GpuRequests),
+			PersistentVolumeClaims:   stringValue(q.PersistentVolumeClaims),
+			Pods:                     stringValue(q.Pods),
+			ReplicationControllers:   stringValue(q.ReplicationControllers),
+			Secrets:                  stringValue(q.Secrets),
+			Services:                 stringValue(q.Services),
+			ServicesLoadBalancers:    stringValue(q.ServicesLoadBalancers),
+			ServicesNodePorts:        stringValue(q.ServicesNodePorts),
+			StorageRequests:          stringValue(q.StorageRequests),
+			EphemeralStorageLimits:   stringValue(q.EphemeralStorageLimits),
+			EphemeralStorageRequests: stringValue(q.EphemeralStorageRequests),
+			state:                    attr.ValueStateKnown,
+		}
+	}
+	out.ResourceQuotas, d = quotas.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	limitRange := NewLimitRangeValueNull()
+	if len(s.LimitRange) > 0 {
+		limitRange = LimitRangeValue{state: attr.ValueStateKnown}
+		limitRange.Pod, d = upgradeLimitRangeConfig(ctx, s.LimitRange[0].Pod)
+		diags.Append(d...)
+		limitRange.Container, d = upgradeLimitRangeConfig(ctx, s.LimitRange[0].Container)
+		diags.Append(d...)
+	}
+	out.LimitRange, d = limitRange.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	params := NewNetworkPolicyParamsValueNull()
+	if len(s.NetworkPolicyParams) > 0 {
+		params = NetworkPolicyParamsValue{
+			NetworkPolicyEnabled: types.BoolValue(s.NetworkPolicyParams[0].NetworkPolicyEnabled),
+			state:                attr.ValueStateKnown,
+		}
+		params.Policies, d = upgradePolicyRefs(ctx, s.NetworkPolicyParams[0].Policies, PoliciesType{
+			ObjectType: types.ObjectType{AttrTypes: PoliciesValue{}.AttributeTypes(ctx)},
+		})
+		diags.Append(d...)
+	}
+	out.NetworkPolicyParams, d = params.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	meshParams := NewNamespaceMeshPolicyParamsValueNull()
+	if len(s.NamespaceMeshPolicyParams) > 0 {
+		meshParams = NamespaceMeshPolicyParamsValue{
+			MeshEnabled: types.BoolValue(s.NamespaceMeshPolicyParams[0].MeshEnabled),
+			state:       attr.ValueStateKnown,
+		}
+		meshParams.Policies2, d = upgradePolicyRefs(ctx, s.NamespaceMeshPolicyParams[0].Policies, Policies2Type{
+			ObjectType: types.ObjectType{AttrTypes: Policies2Value{}.AttributeTypes(ctx)},
+		})
+		diags.Append(d...)
+	}
+	out.NamespaceMeshPolicyParams, d = meshParams.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	artifact := NewArtifactValueNull()
+	if len(s.Artifact) > 0 {
+		a := s.Artifact[0]
+		artifact = ArtifactValue{
+			Repository: stringValue(a.Repository),
+			Revision:   stringValue(a.Revision),
+			state:      attr.ValueStateKnown,
+		}
+		if len(a.Path) > 0 {
+			artifact.Path, d = PathValue{
+				Name:      stringValue(a.Path[0].Name),
+				Sensitive: types.BoolValue(a.Path[0].Sensitive),
+				state:     attr.ValueStateKnown,
+			}.ToObjectValue(ctx)
+			diags.Append(d...)
+		}
+	}
+	out.Artifact, d = artifact.ToObjectValue(ctx)
+	diags.Append(d...)
+
+	return out, diags
+}
+
+// UpgradeNamespaceStateV1 converts the JSON state of the SDKv2
+// rafay_namespace, at schema version 0 or 1, to NamespaceModel. Empty
+// strings, which the SDK stores for unset attributes, become null.
+func UpgradeNamespaceStateV1(ctx context.Context, rawState []byte) (NamespaceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var prior namespaceStateV1
+	if err := json.Unmarshal(rawState, &prior); err != nil {
+		diags.AddError("Unable to Upgrade Resource State", "Unable to decode the rafay_namespace state: "+err.Error())
+		return NamespaceModel{}, diags
+	}
+	if len(prior.Metadata) == 0 || prior.Metadata[0].Name == "" {
+		diags.AddError("Unable to Upgrade Resource State", "The rafay_namespace state has no metadata name.")
+		return NamespaceModel{}, diags
+	}
+
+	metadata := prior.Metadata[0]
+	labels, d := upgradeMap(ctx, metadata.Labels)
+	diags.Append(d...)
+	annotations, d := upgradeMap(ctx, metadata.Annotations)
+	diags.Append(d...)
+
+	out := NamespaceModel{
+		Id:          types.StringValue(metadata.Name),
+		Impersonate: stringValue(prior.Impersonate),
+		Metadata: MetadataValue{
+			Name:        types.StringValue(metadata.Name),
+			Project:     stringValue(metadata.Project),
+			Description: stringValue(metadata.Description),
+			Labels:      labels,
+			Annotations: annotations,
+			state:       attr.ValueStateKnown,
+		},
+		Timeouts: NewTimeoutsValueNull(),
+	}
+
+	spec := specV1{}
+	if len(prior.Spec) > 0 {
+		spec = prior.Spec[0]
+	}
+	out.Spec, d = spec.upgrade(ctx)
+	diags.Append(d...)
+
+	if prior.Timeouts != nil {
+		out.Timeouts = TimeoutsValue{
+			Create: stringValue(prior.Timeouts.Create),
+			Update: stringValue(prior.Timeouts.Update),
+			Delete: stringValue(prior.Timeouts.Delete),
+			state:  attr.ValueStateKnown,
+		}
+	}
+
+	return out, diags
+}
@@ -0,0 +1,150 @@
+package resource_namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const namespaceStateV1JSON = `{
+  "id": "cloudops",
+  "impersonate": "",
+  "metadata": [{
+    "name": "cloudops",
+    "project": "terraform",
+    "description": "",
+    "labels": {"env": "prod"},
+    "annotations": {}
+  }],
+  "spec": [{
+    "drift": [{"enabled": false, "action": ""}],
+    "placement": [{
+      "labels": [{"key": "rafay.dev/clusterName", "value": "c1"}],
+      "selector": "",
+      "environment": []
+    }],
+    "psp": [],
+    "resource_quotas": [{"cpu_limits": "4000m", "memory_limits": "4096Mi", "pods": ""}],
+    "limit_range": [{
+      "pod": [{
+        "max": [{"cpu": "500m", "memory": "128Mi"}],
+        "min": [],
+        "default": [],
+        "default_request": [],
+        "ratio": [{"cpu": 1, "memory": 0}]
+      }],
+      "container": []
+    }],
+    "network_policy_params": [{
+      "network_policy_enabled": true,
+      "policies": [{"name": "deny-all", "version": "v1"}]
+    }],
+    "namespace_mesh_policy_params": [],
+    "artifact": []
+  }],
+  "timeouts": {"create": "20m", "update": null, "delete": null}
+}`
+
+func TestUpgradeNamespaceStateV1(t *testing.T) {
+	ctx := context.Background()
+
+	got, diags := UpgradeNamespaceStateV1(ctx, []byte(namespaceStateV1JSON))
+	if diags.HasError() {
+		t.Fatalf("unexpected diags: %v", diags)
+	}
+
+	if got.Id.ValueString() != "cloudops" {
+		t.Errorf("id = %s, want cloudops", got.Id)
+	}
+	if !got.Impersonate.IsNull() {
+		t.Errorf("impersonate = %s, want null", got.Impersonate)
+	}
+	if got.Metadata.Project.ValueString() != "terraform" {
+		t.Errorf("project = %s, want terraform", got.Metadata.Project)
+	}
+	if !got.Metadata.Description.IsNull() {
+		t.Errorf("description = %s, want null", got.Metadata.Description)
+	}
+	if got.Metadata.Labels.IsNull() || len(got.Metadata.Labels.Elements()) != 1 {
+		t.Errorf("labels = %s, want one label", got.Metadata.Labels)
+	}
+	if !got.Metadata.Annotations.IsNull() {
+		t.Errorf("annotations = %s, want null", got.Metadata.Annotations)
+	}
+
+	spec := got.Spec
+	drift := spec.Drift.Attributes()
+	if drift["enabled"].(types.Bool).ValueBool() || !drift["action"].IsNull() {
+		t.Errorf("drift = %s, want disabled without action", spec.Drift)
+	}
+
+	placement, diags := PlacementType{}.ValueFromObject(ctx, spec.Placement)
+	if diags.HasError() {
+		t.Fatalf("unexpected placement diags: %v", diags)
+	}
+	labels := placement.(PlacementValue).Labels.Elements()
+	if len(labels) != 1 || labels[0].(LabelsValue).Value.ValueString() != "c1" {
+		t.Errorf("placement = %s, want the c1 label", spec.Placement)
+	}
+	if !placement.(PlacementValue).Environment.IsNull() || !placement.(PlacementValue).Selector.IsNull() {
+		t.Errorf("placement = %s, want no environment or selector", spec.Placement)
+	}
+	if !spec.Psp.IsNull() || !spec.NamespaceMeshPolicyParams.IsNull() || !spec.Artifact.IsNull() {
+		t.Errorf("spec = %s, want empty blocks to be null", spec)
+	}
+	quotas := spec.ResourceQuotas.Attributes()
+	if quotas["cpu_limits"].(types.String).ValueString() != "4000m" || !quotas["pods"].IsNull() {
+		t.Errorf("resource_quotas = %s", spec.ResourceQuotas)
+	}
+
+	limitRange := spec.LimitRange.Attributes()
+	pod := limitRange["pod"].(basetypes.ObjectValue).Attributes()
+	podMax := pod["max"].(basetypes.ObjectValue).Attributes()
+	if podMax["cpu"].(types.String).ValueString() != "500m" || !pod["min"].IsNull() {
+		t.Errorf("limit_range.pod = %s", limitRange["pod"])
+	}
+	ratio := pod["ratio"].(basetypes.ObjectValue).Attributes()
+	if !ratio["cpu"].Equal(types.Float64Value(1)) || !ratio["memory"].IsNull() {
+		t.Errorf("limit_range.pod.ratio = %s", pod["ratio"])
+	}
+	if !limitRange["container"].IsNull() {
+		t.Errorf("limit_range.container = %s, want null", limitRange["container"])
+	}
+
+	params, diags := NetworkPolicyParamsType{}.ValueFromObject(ctx, spec.NetworkPolicyParams)
+	if diags.HasError() {
+		t.Fatalf("unexpected network_policy_params diags: %v", diags)
+	}
+	policies := params.(NetworkPolicyParamsValue).Policies.Elements()
+	if !params.(NetworkPolicyParamsValue).NetworkPolicyEnabled.ValueBool() || len(policies) != 1 || policies[0].(PoliciesValue).Name.ValueString() != "deny-all" {
+		t.Errorf("network_policy_params = %s", spec.NetworkPolicyParams)
+	}
+
+	if got.Timeouts.Create.ValueString() != "20m" || !got.Timeouts.Update.IsNull() {
+		t.Errorf("timeouts = %s", got.Timeouts)
+	}
+
+	// The upgraded state must be writable to the new schema.
+	if _, err := got.Spec.ToTerraformValue(ctx); err != nil {
+		t.Errorf("spec cannot be written: %s", err)
+	}
+}
+
+func TestUpgradeNamespaceStateV1Invalid(t *testing.T) {
+	cases := map[string]string{
+		"not json":    `{"metadata":`,
+		"no metadata": `{"id": "cloudops", "metadata": []}`,
+		"no name":     `{"id": "cloudops", "metadata": [{"project": "terraform"}]}`,
+	}
+
+	for name, state := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, diags := UpgradeNamespaceStateV1(context.Background(), []byte(state))
+			if !diags.HasError() {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
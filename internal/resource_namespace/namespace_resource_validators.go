@@ -0,0 +1,114 @@
+package resource_namespace
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// Quantity validates that a string is a Kubernetes resource quantity, such
+// as `500m`, `128Mi` or `10`.
+func Quantity() validator.String {
+	return quantityValidator{}
+}
+
+type quantityValidator struct{}
+
+func (v quantityValidator) Description(ctx context.Context) string {
+	return "value must be a Kubernetes resource quantity, e.g. 500m, 128Mi or 10"
+}
+
+func (v quantityValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a Kubernetes resource quantity, e.g. `500m`, `128Mi` or `10`"
+}
+
+func (v quantityValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	q, err := resource.ParseQuantity(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Resource Quantity",
+			fmt.Sprintf("%q is not a Kubernetes resource quantity, e.g. 500m, 128Mi or 10: %s", req.ConfigValue.ValueString(), err))
+		return
+	}
+	if q.Sign() < 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Resource Quantity",
+			fmt.Sprintf("%q must not be negative", req.ConfigValue.ValueString()))
+	}
+}
+
+// ResourceName validates that a string is a lowercase RFC 1123 subdomain,
+// as the console requires of namespace names.
+func ResourceName() validator.String {
+	return resourceNameValidator{}
+}
+
+type resourceNameValidator struct{}
+
+func (v resourceNameValidator) Description(ctx context.Context) string {
+	return "value must be a lowercase RFC 1123 subdomain"
+}
+
+func (v resourceNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v resourceNameValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if errs := validation.IsDNS1123Subdomain(req.ConfigValue.ValueString()); len(errs) != 0 {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Name", strings.Join(errs, " "))
+	}
+}
+
+// UniquePolicyNames validates that a list of policy references names each
+// policy at most once.
+func UniquePolicyNames() validator.List {
+	return uniquePolicyNamesValidator{}
+}
+
+type uniquePolicyNamesValidator struct{}
+
+func (v uniquePolicyNamesValidator) Description(ctx context.Context) string {
+	return "each policy must be listed at most once"
+}
+
+func (v uniquePolicyNamesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v uniquePolicyNamesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	seen := map[string]bool{}
+	for i, elem := range req.ConfigValue.Elements() {
+		attrs, diags := objectAttributes(ctx, elem)
+		resp.Diagnostics.Append(diags...)
+		if attrs == nil {
+			continue
+		}
+		name, ok := attrs["name"].(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+		if seen[name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), "Duplicate Policy",
+				fmt.Sprintf("policy %q is listed more than once", name.ValueString()))
+		}
+		seen[name.ValueString()] = true
+	}
+}
+
+var (
+	_ validator.String = quantityValidator{}
+	_ validator.String = resourceNameValidator{}
+	_ validator.List   = uniquePolicyNamesValidator{}
+)
@@ -0,0 +1,96 @@
+package resource_namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestQuantity(t *testing.T) {
+	cases := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{value: types.StringValue("500m")},
+		{value: types.StringValue("128Mi")},
+		{value: types.StringValue("10")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("128MB"), wantErr: true},
+		{value: types.StringValue("lots"), wantErr: true},
+		{value: types.StringValue("-1"), wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.value.String(), func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("cpu"), ConfigValue: tc.value}
+			resp := &validator.StringResponse{}
+			Quantity().ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("got diags %v, want error %t", resp.Diagnostics, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	cases := []struct {
+		value   string
+		wantErr bool
+	}{
+		{value: "cloudops"},
+		{value: "cloud-ops.prod"},
+		{value: "CloudOps", wantErr: true},
+		{value: "cloud_ops", wantErr: true},
+		{value: "-cloudops", wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.value, func(t *testing.T) {
+			req := validator.StringRequest{Path: path.Root("name"), ConfigValue: types.StringValue(tc.value)}
+			resp := &validator.StringResponse{}
+			ResourceName().ValidateString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("got diags %v, want error %t", resp.Diagnostics, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestUniquePolicyNames(t *testing.T) {
+	// The framework passes the elements as the generated PoliciesValue.
+	policy := func(name string) attr.Value {
+		return PoliciesValue{
+			Name:    types.StringValue(name),
+			Version: types.StringNull(),
+			state:   attr.ValueStateKnown,
+		}
+	}
+
+	cases := []struct {
+		name     string
+		policies []attr.Value
+		wantErr  bool
+	}{
+		{name: "unique", policies: []attr.Value{policy("allow-dns"), policy("deny-all")}},
+		{name: "duplicate", policies: []attr.Value{policy("deny-all"), policy("allow-dns"), policy("deny-all")}, wantErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.ListRequest{
+				Path:        path.Root("policies"),
+				ConfigValue: types.ListValueMust(PoliciesValue{}.Type(context.Background()), tc.policies),
+			}
+			resp := &validator.ListResponse{}
+			UniquePolicyNames().ValidateList(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tc.wantErr {
+				t.Fatalf("got diags %v, want error %t", resp.Diagnostics, tc.wantErr)
+			}
+		})
+	}
+}
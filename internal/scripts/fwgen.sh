@@ -91,3 +91,16 @@ skip { if ($0 == "var _ basetypes.ObjectTypable = Placement4Type{}") { skip=0; p
 # Add Version to schema if not already present (run once after codegen; re-run is idempotent)
 grep -q 'Version: 1' internal/resource_eks_cluster/eks_cluster_resource_gen.go || \
 	sed -i 's/return schema.Schema{$/return schema.Schema{\n\t\tVersion: 1,/' internal/resource_eks_cluster/eks_cluster_resource_gen.go
+
+# namespace fields
+sed -i 's/"default1"/"default"/g' internal/resource_namespace/namespace_resource_gen.go
+sed -i 's/"min2"/"min"/g' internal/resource_namespace/namespace_resource_gen.go
+sed -i 's/"max2"/"max"/g' internal/resource_namespace/namespace_resource_gen.go
+sed -i 's/"default2"/"default"/g' internal/resource_namespace/namespace_resource_gen.go
+sed -i 's/"default_request2"/"default_request"/g' internal/resource_namespace/namespace_resource_gen.go
+sed -i 's/"ratio2"/"ratio"/g' internal/resource_namespace/namespace_resource_gen.go
+sed -i 's/"policies2"/"policies"/g' internal/resource_namespace/namespace_resource_gen.go
+
+# Add Version to the namespace schema, which upgrades the SDKv2 versions 0 and 1
+grep -q 'Version: 2' internal/resource_namespace/namespace_resource_gen.go || \
+	sed -i 's/return schema.Schema{$/return schema.Schema{\n\t\tVersion: 2,/' internal/resource_namespace/namespace_resource_gen.go
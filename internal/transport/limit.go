@@ -0,0 +1,174 @@
+package transport
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// EnvRequestsPerSecond and EnvMaxConcurrentRequests set
+	// requests_per_second and max_concurrent_requests when the provider
+	// block leaves them out.
+	EnvRequestsPerSecond     = "RAFAY_REQUESTS_PER_SECOND"
+	EnvMaxConcurrentRequests = "RAFAY_MAX_CONCURRENT_REQUESTS"
+)
+
+// Limits bounds the request rate and the number of requests in flight to
+// the Rafay console. Zero values mean unlimited.
+type Limits struct {
+	// RequestsPerSecond is the sustained rate of the token bucket. Bursts
+	// of up to one second's worth of requests are allowed.
+	RequestsPerSecond float64
+	// MaxConcurrentRequests caps the requests in flight, from sending them
+	// until their response body is read or closed.
+	MaxConcurrentRequests int
+}
+
+var (
+	limitMu sync.RWMutex
+	limits  Limits
+	bucket  *rate.Limiter
+	// slots is a counting semaphore; nil when concurrency is unlimited.
+	slots chan struct{}
+)
+
+// LimitsFromConfig builds Limits from the provider's requests_per_second and
+// max_concurrent_requests settings. A nil value means the setting is absent;
+// it is then read from the environment, falling back to unlimited. Negative
+// values are an error wherever they come from.
+func LimitsFromConfig(requestsPerSecond *float64, maxConcurrent *int) (Limits, error) {
+	var l Limits
+
+	if requestsPerSecond != nil {
+		l.RequestsPerSecond = *requestsPerSecond
+	} else if v := os.Getenv(EnvRequestsPerSecond); v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return l, fmt.Errorf("invalid %s %q: %w", EnvRequestsPerSecond, v, err)
+		}
+		l.RequestsPerSecond = f
+	}
+	if l.RequestsPerSecond < 0 || math.IsNaN(l.RequestsPerSecond) || math.IsInf(l.RequestsPerSecond, 0) {
+		return Limits{}, fmt.Errorf("requests_per_second must be a non-negative number, got %v", l.RequestsPerSecond)
+	}
+
+	if maxConcurrent != nil {
+		l.MaxConcurrentRequests = *maxConcurrent
+	} else if v := os.Getenv(EnvMaxConcurrentRequests); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			return l, fmt.Errorf("invalid %s %q: %w", EnvMaxConcurrentRequests, v, err)
+		}
+		l.MaxConcurrentRequests = n
+	}
+	if l.MaxConcurrentRequests < 0 {
+		return Limits{}, fmt.Errorf("max_concurrent_requests must not be negative, got %d", l.MaxConcurrentRequests)
+	}
+	return l, nil
+}
+
+// ConfigureLimits sets the limits shared by every LimitTransport of the
+// process, so requests from the SDK and framework halves of the provider
+// draw from the same bucket, and makes sure http.DefaultTransport goes
+// through them. Reconfiguring with unchanged limits keeps the current
+// bucket and semaphore.
+func ConfigureLimits(l Limits) {
+	mu.Lock()
+	install()
+	mu.Unlock()
+
+	limitMu.Lock()
+	defer limitMu.Unlock()
+	if l == limits {
+		return
+	}
+	limits = l
+
+	bucket = nil
+	if l.RequestsPerSecond > 0 {
+		burst := int(math.Ceil(l.RequestsPerSecond))
+		bucket = rate.NewLimiter(rate.Limit(l.RequestsPerSecond), burst)
+	}
+	// Requests in flight keep releasing to the semaphore they acquired.
+	slots = nil
+	if l.MaxConcurrentRequests > 0 {
+		slots = make(chan struct{}, l.MaxConcurrentRequests)
+	}
+}
+
+// CurrentLimits returns the limits set by the last call to ConfigureLimits.
+func CurrentLimits() Limits {
+	limitMu.RLock()
+	defer limitMu.RUnlock()
+	return limits
+}
+
+// LimitTransport waits for a token and a free concurrency slot before
+// sending a request. The slot is held until the response body is read or
+// closed. It sits below RetryTransport, so every retry is limited as well.
+type LimitTransport struct {
+	Base http.RoundTripper
+}
+
+func (t *LimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = defaultBase()
+	}
+
+	limitMu.RLock()
+	b, sem := bucket, slots
+	limitMu.RUnlock()
+
+	ctx := req.Context()
+	if b != nil {
+		if err := b.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if sem == nil {
+		return base.RoundTrip(req)
+	}
+
+	select {
+	case sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	release := sync.OnceFunc(func() { <-sem })
+	resp, err := base.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		release()
+		return resp, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releasingBody holds the concurrency slot of a request until its response
+// body has been read to the end or closed, so that reading large responses
+// counts against max_concurrent_requests too.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releasingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.release()
+	}
+	return n, err
+}
+
+func (b *releasingBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,158 @@
+package transport
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestLimitTransportCapsConcurrency(t *testing.T) {
+	ConfigureLimits(Limits{MaxConcurrentRequests: 2})
+	defer ConfigureLimits(Limits{})
+
+	var inFlight, peak int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		for {
+			p := atomic.LoadInt32(&peak)
+			if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&inFlight, -1)
+	}))
+	defer srv.Close()
+
+	// The typed hub clients are built on ForHub and share the limits.
+	for name, rt := range map[string]http.RoundTripper{
+		"LimitTransport": &LimitTransport{Base: srv.Client().Transport},
+		"ForHub":         ForHub(false),
+	} {
+		atomic.StoreInt32(&peak, 0)
+		client := &http.Client{Transport: rt}
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, err := client.Get(srv.URL)
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				resp.Body.Close()
+			}()
+		}
+		wg.Wait()
+		if p := atomic.LoadInt32(&peak); p > 2 {
+			t.Errorf("%s: %d requests in flight, want at most 2", name, p)
+		}
+	}
+}
+
+func TestLimitTransportHoldsSlotUntilBodyClosed(t *testing.T) {
+	ConfigureLimits(Limits{MaxConcurrentRequests: 1})
+	defer ConfigureLimits(Limits{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("body"))
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &LimitTransport{Base: srv.Client().Transport}}
+	first, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("second request sent while the first body was still open")
+	case <-time.After(50 * time.Millisecond):
+	}
+	first.Body.Close()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("second request still waiting after the first body was closed")
+	}
+}
+
+func TestLimitTransportRate(t *testing.T) {
+	ConfigureLimits(Limits{RequestsPerSecond: 50})
+	defer ConfigureLimits(Limits{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &LimitTransport{Base: srv.Client().Transport}}
+	start := time.Now()
+	// The first 50 requests use up the burst, the next 25 take half a second.
+	for i := 0; i < 75; i++ {
+		resp, err := client.Get(srv.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("75 requests took %s, want at least 400ms at 50 requests per second", elapsed)
+	}
+}
+
+func TestLimitsFromConfig(t *testing.T) {
+	t.Setenv(EnvRequestsPerSecond, "2.5")
+	t.Setenv(EnvMaxConcurrentRequests, "")
+
+	l, err := LimitsFromConfig(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.RequestsPerSecond != 2.5 || l.MaxConcurrentRequests != 0 {
+		t.Errorf("got %+v", l)
+	}
+
+	rps, concurrent := 0.0, 10
+	l, err = LimitsFromConfig(&rps, &concurrent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.RequestsPerSecond != 0 || l.MaxConcurrentRequests != 10 {
+		t.Errorf("got %+v", l)
+	}
+
+	rps, concurrent = -1, 0
+	if _, err := LimitsFromConfig(&rps, &concurrent); err == nil {
+		t.Error("expected an error for a negative requests_per_second")
+	}
+	rps, concurrent = 0, -1
+	if _, err := LimitsFromConfig(&rps, &concurrent); err == nil {
+		t.Error("expected an error for a negative max_concurrent_requests")
+	}
+
+	t.Setenv(EnvMaxConcurrentRequests, "-2")
+	if _, err := LimitsFromConfig(nil, nil); err == nil {
+		t.Error("expected an error for a negative environment value")
+	}
+	t.Setenv(EnvMaxConcurrentRequests, "many")
+	if _, err := LimitsFromConfig(nil, nil); err == nil {
+		t.Error("expected an error for an invalid environment value")
+	}
+}
@@ -0,0 +1,380 @@
+// Package transport holds the HTTP round trippers shared by the SDK and
+// framework halves of the muxed provider. They wrap http.DefaultTransport,
+// which the rctl request helpers send through, and the typed hub clients
+// are built on ForHub, so every call to the Rafay console follows one retry
+// policy and one set of limits whichever client issued it.
+package transport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"os"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// EnvMaxRetries and EnvRetryMaxWait set max_retries and retry_max_wait
+	// when the provider block leaves them out.
+	EnvMaxRetries   = "RAFAY_MAX_RETRIES"
+	EnvRetryMaxWait = "RAFAY_RETRY_MAX_WAIT"
+
+	DefaultMaxRetries   = 4
+	DefaultRetryMaxWait = 30 * time.Second
+
+	// retryBaseWait is the backoff before the first retry. It doubles with
+	// every further attempt up to the policy's MaxWait.
+	retryBaseWait = time.Second
+)
+
+// RetryPolicy controls how failed requests are retried.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt. Zero
+	// disables retrying.
+	MaxRetries int
+	// MaxWait caps the wait before any single retry, including waits
+	// requested by the server through Retry-After.
+	MaxWait time.Duration
+}
+
+// DefaultRetryPolicy is used until a provider is configured.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: DefaultMaxRetries,
+	MaxWait:    DefaultRetryMaxWait,
+}
+
+var (
+	mu     sync.RWMutex
+	policy = DefaultRetryPolicy
+	// origin is the round tripper the wrappers send through: a clone owned
+	// by this package of the http.DefaultTransport found when install first
+	// ran, or that transport itself if it is not an *http.Transport.
+	origin    http.RoundTripper
+	installed *RetryTransport
+)
+
+// PolicyFromConfig builds a RetryPolicy from the provider's max_retries and
+// retry_max_wait settings. A negative maxRetries or an empty maxWait means
+// the setting is absent; it is then read from the environment, falling back
+// to the defaults.
+func PolicyFromConfig(maxRetries int, maxWait string) (RetryPolicy, error) {
+	p := DefaultRetryPolicy
+
+	if maxRetries < 0 {
+		if v := os.Getenv(EnvMaxRetries); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return p, fmt.Errorf("invalid %s %q: %w", EnvMaxRetries, v, err)
+			}
+			maxRetries = n
+		}
+	}
+	if maxRetries >= 0 {
+		p.MaxRetries = maxRetries
+	}
+
+	if maxWait == "" {
+		maxWait = os.Getenv(EnvRetryMaxWait)
+	}
+	if maxWait != "" {
+		d, err := time.ParseDuration(maxWait)
+		if err != nil {
+			return p, fmt.Errorf("invalid retry_max_wait %q: %w", maxWait, err)
+		}
+		if d <= 0 {
+			return p, fmt.Errorf("retry_max_wait %q must be a positive duration", maxWait)
+		}
+		p.MaxWait = d
+	}
+	return p, nil
+}
+
+// Configure sets the retry policy used by every RetryTransport of the
+// process and makes sure http.DefaultTransport, which the rctl request
+// helpers use, is wrapped with one. Both provider halves call it with the
+// same provider block, so calling it more than once is harmless.
+func Configure(p RetryPolicy) {
+	mu.Lock()
+	defer mu.Unlock()
+	policy = p
+	install()
+}
+
+// install wraps http.DefaultTransport with the retry and limit transports
+// once. mu must be held.
+func install() {
+	if installed != nil {
+		return
+	}
+	origin = http.DefaultTransport
+	if t, ok := origin.(*http.Transport); ok {
+		origin = t.Clone()
+	}
+	installed = &RetryTransport{Base: &LimitTransport{}}
+	http.DefaultTransport = installed
+}
+
+// CurrentPolicy returns the policy set by the last call to Configure.
+func CurrentPolicy() RetryPolicy {
+	mu.RLock()
+	defer mu.RUnlock()
+	return policy
+}
+
+// defaultBase is the round tripper for a transport without a Base. Once
+// installed, http.DefaultTransport would send requests back through the
+// wrappers, so the original transport is used instead.
+func defaultBase() http.RoundTripper {
+	mu.RLock()
+	defer mu.RUnlock()
+	if installed != nil {
+		return origin
+	}
+	return http.DefaultTransport
+}
+
+// SetInsecureSkipVerify turns certificate verification of the requests sent
+// through http.DefaultTransport off or back on. The setting goes to a new
+// clone of the transport underneath the wrappers; the transport the process
+// started with is left alone. It fails if that transport is not an
+// *http.Transport, whose TLS settings cannot be changed.
+func SetInsecureSkipVerify(skip bool) error {
+	mu.Lock()
+	defer mu.Unlock()
+	install()
+	t, ok := origin.(*http.Transport)
+	if !ok {
+		return fmt.Errorf("cannot set TLS verification on the default HTTP transport of type %T", origin)
+	}
+	origin = withInsecureSkipVerify(t, skip)
+	return nil
+}
+
+// withInsecureSkipVerify returns a clone of t verifying certificates as
+// requested.
+func withInsecureSkipVerify(t *http.Transport, skip bool) *http.Transport {
+	c := t.Clone()
+	if c.TLSClientConfig == nil {
+		c.TLSClientConfig = &tls.Config{}
+	}
+	c.TLSClientConfig.InsecureSkipVerify = skip
+	return c
+}
+
+// ForHub returns the round tripper for a typed hub client: a clone of the
+// transport underneath http.DefaultTransport verifying certificates as
+// requested, wrapped with the shared limiter and retry policy. A base that
+// is not an *http.Transport is used as it is.
+func ForHub(insecureSkipVerify bool) http.RoundTripper {
+	base := defaultBase()
+	if t, ok := base.(*http.Transport); ok {
+		base = withInsecureSkipVerify(t, insecureSkipVerify)
+	}
+	return &RetryTransport{Base: &LimitTransport{Base: base}}
+}
+
+type replaySafeKey struct{}
+
+// ReplaySafe marks the requests made with ctx as safe to send more than
+// once, so that they are retried like GET requests whatever their method.
+func ReplaySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replaySafeKey{}, true)
+}
+
+// isReplayable reports whether req may be sent again after the server might
+// have acted on it: it is a GET, HEAD, OPTIONS or TRACE, carries an
+// idempotency key, or was marked with ReplaySafe.
+func isReplayable(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace:
+		return true
+	}
+	if req.Header.Get("Idempotency-Key") != "" || req.Header.Get("X-Idempotency-Key") != "" {
+		return true
+	}
+	safe, _ := req.Context().Value(replaySafeKey{}).(bool)
+	return safe
+}
+
+// RetryTransport retries requests that failed with a throttling or server
+// error status, or with a dropped connection, using exponential backoff
+// with jitter. A Retry-After header on the response takes precedence over
+// the computed backoff.
+//
+// Only replayable requests (see isReplayable) are retried on every such
+// failure. Other requests, such as the POSTs creating objects or triggering
+// deploys, may already have been acted on when the server fails or the
+// connection drops, so they are only retried when the server refused them
+// with 429 or 503, or when they failed before being written.
+type RetryTransport struct {
+	Base http.RoundTripper
+	// Policy overrides the process-wide policy when set.
+	Policy *RetryPolicy
+}
+
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	p := CurrentPolicy()
+	if t.Policy != nil {
+		p = *t.Policy
+	}
+	base := t.Base
+	if base == nil {
+		base = defaultBase()
+	}
+
+	// The body has to be replayable to be sent more than once.
+	if req.Body != nil && req.GetBody == nil && p.MaxRetries > 0 {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.Body, _ = req.GetBody()
+	}
+
+	replayable := isReplayable(req)
+	for attempt := 0; ; attempt++ {
+		var written bool
+		sent := req
+		if !replayable {
+			sent = req.WithContext(httptrace.WithClientTrace(req.Context(), &httptrace.ClientTrace{
+				WroteRequest: func(httptrace.WroteRequestInfo) { written = true },
+			}))
+		}
+		resp, err := base.RoundTrip(sent)
+		if attempt >= p.MaxRetries || !shouldRetry(resp, err, replayable, written) {
+			return resp, err
+		}
+
+		wait := backoff(attempt, p.MaxWait)
+		if resp != nil {
+			if after, ok := retryAfter(resp, p.MaxWait); ok {
+				wait = after
+			}
+			// Drain so the connection can be reused.
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 4<<10))
+			resp.Body.Close()
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+		}
+		tflog.Warn(req.Context(), "retrying Rafay API request", map[string]any{
+			"method":  req.Method,
+			"url":     req.URL.Redacted(),
+			"attempt": attempt + 1,
+			"reason":  reason,
+			"wait":    wait.String(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// IsRetryableStatus reports whether a response with the given status code
+// is worth retrying.
+func IsRetryableStatus(code int) bool {
+	switch code {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// shouldRetry reports whether a request that got resp or err is worth
+// sending again. Requests that are not replayable are only resent when the
+// server cannot have acted on them: it refused them, or they were not
+// written at all.
+func shouldRetry(resp *http.Response, err error, replayable, written bool) bool {
+	if err != nil {
+		if !replayable && written {
+			return false
+		}
+		return isRetryableError(err)
+	}
+	if !replayable {
+		return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+	}
+	return IsRetryableStatus(resp.StatusCode)
+}
+
+func isRetryableError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// backoff returns a random wait in [d/2, d) where d doubles per attempt
+// from retryBaseWait, capped at max.
+func backoff(attempt int, max time.Duration) time.Duration {
+	d := retryBaseWait << uint(attempt)
+	if d <= 0 || d > max {
+		d = max
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryAfter parses the Retry-After header, given either in seconds or as
+// an HTTP date, capped at max.
+func retryAfter(resp *http.Response, max time.Duration) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	var d time.Duration
+	if secs, err := strconv.Atoi(v); err == nil {
+		d = time.Duration(secs) * time.Second
+	} else if at, err := http.ParseTime(v); err == nil {
+		d = time.Until(at)
+	} else {
+		return 0, false
+	}
+	if d < 0 {
+		d = 0
+	}
+	if d > max {
+		d = max
+	}
+	return d, true
+}
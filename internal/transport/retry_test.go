@@ -0,0 +1,274 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testPolicy(retries int) *RetryPolicy {
+	return &RetryPolicy{MaxRetries: retries, MaxWait: 10 * time.Millisecond}
+}
+
+func TestRetryTransportRetriesServerErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if string(body) != "payload" {
+			t.Errorf("attempt %d got body %q", atomic.LoadInt32(&calls)+1, body)
+		}
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &RetryTransport{Base: srv.Client().Transport, Policy: testPolicy(4)}}
+	req, err := http.NewRequestWithContext(ReplaySafe(context.Background()), http.MethodPut, srv.URL, strings.NewReader("payload"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("got status %d", resp.StatusCode)
+	}
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("server called %d times, want 3", atomic.LoadInt32(&calls))
+	}
+}
+
+func TestRetryTransportNonIdempotentRequests(t *testing.T) {
+	for _, tt := range []struct {
+		status int
+		calls  int32
+	}{
+		{http.StatusInternalServerError, 1},
+		{http.StatusBadGateway, 1},
+		{http.StatusGatewayTimeout, 1},
+		{http.StatusTooManyRequests, 3},
+		{http.StatusServiceUnavailable, 3},
+	} {
+		var calls int32
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			w.WriteHeader(tt.status)
+		}))
+
+		client := &http.Client{Transport: &RetryTransport{Base: srv.Client().Transport, Policy: testPolicy(2)}}
+		resp, err := client.Post(srv.URL, "text/plain", strings.NewReader("payload"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		srv.Close()
+		if atomic.LoadInt32(&calls) != tt.calls {
+			t.Errorf("POST answered with %d: server called %d times, want %d", tt.status, atomic.LoadInt32(&calls), tt.calls)
+		}
+	}
+}
+
+func TestRetryTransportNonIdempotentDroppedConnection(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		io.ReadAll(r.Body)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &RetryTransport{Base: srv.Client().Transport, Policy: testPolicy(2)}}
+	if _, err := client.Post(srv.URL, "text/plain", strings.NewReader("payload")); err == nil {
+		t.Fatal("expected the dropped connection to fail the request")
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("server called %d times, want a written POST sent once", atomic.LoadInt32(&calls))
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	if _, err := client.Get(srv.URL); err == nil {
+		t.Fatal("expected the dropped connection to fail the request")
+	}
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("server called %d times, want a GET retried twice", atomic.LoadInt32(&calls))
+	}
+}
+
+func TestRetryTransportUnwrittenRequests(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := srv.URL
+	srv.Close()
+
+	var attempts int32
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		atomic.AddInt32(&attempts, 1)
+		return (&http.Transport{}).RoundTrip(req)
+	})
+	client := &http.Client{Transport: &RetryTransport{Base: base, Policy: testPolicy(2)}}
+	if _, err := client.Post(url, "text/plain", strings.NewReader("payload")); err == nil {
+		t.Fatal("expected the refused connection to fail the request")
+	}
+	if atomic.LoadInt32(&attempts) != 3 {
+		t.Errorf("sent %d times, want a refused POST retried twice", atomic.LoadInt32(&attempts))
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestRetryTransportGivesUp(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &RetryTransport{Base: srv.Client().Transport, Policy: testPolicy(2)}}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Errorf("got status %d", resp.StatusCode)
+	}
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("server called %d times, want 3", atomic.LoadInt32(&calls))
+	}
+}
+
+func TestRetryTransportDoesNotRetryClientErrors(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := &http.Client{Transport: &RetryTransport{Base: srv.Client().Transport, Policy: testPolicy(4)}}
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("server called %d times, want 1", atomic.LoadInt32(&calls))
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if d, ok := retryAfter(resp, time.Minute); !ok || d != time.Minute {
+		t.Errorf("got %s %v, want the wait capped at 1m", d, ok)
+	}
+	resp.Header.Set("Retry-After", "3")
+	if d, ok := retryAfter(resp, time.Minute); !ok || d != 3*time.Second {
+		t.Errorf("got %s %v, want 3s", d, ok)
+	}
+}
+
+func TestPolicyFromConfig(t *testing.T) {
+	t.Setenv(EnvMaxRetries, "7")
+	t.Setenv(EnvRetryMaxWait, "")
+
+	p, err := PolicyFromConfig(-1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.MaxRetries != 7 || p.MaxWait != DefaultRetryMaxWait {
+		t.Errorf("got %+v", p)
+	}
+
+	p, err = PolicyFromConfig(0, "2m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.MaxRetries != 0 || p.MaxWait != 2*time.Minute {
+		t.Errorf("got %+v", p)
+	}
+
+	if _, err := PolicyFromConfig(1, "later"); err == nil {
+		t.Error("expected an error for an invalid duration")
+	}
+}
+
+func TestForHubRetriesAndVerifiesAsRequested(t *testing.T) {
+	Configure(*testPolicy(2))
+	t.Cleanup(func() { Configure(DefaultRetryPolicy) })
+
+	var calls int32
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	if _, err := (&http.Client{Transport: ForHub(false)}).Get(srv.URL); err == nil {
+		t.Fatal("expected a certificate error with verification on")
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	resp, err := (&http.Client{Transport: ForHub(true)}).Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("got status %d after %d calls, want 200 after 2", resp.StatusCode, atomic.LoadInt32(&calls))
+	}
+}
+
+func TestSetInsecureSkipVerify(t *testing.T) {
+	t.Cleanup(func() {
+		if err := SetInsecureSkipVerify(false); err != nil {
+			t.Error(err)
+		}
+	})
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	mu.Lock()
+	install()
+	before := origin.(*http.Transport)
+	mu.Unlock()
+
+	if err := SetInsecureSkipVerify(true); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if before.TLSClientConfig != nil && before.TLSClientConfig.InsecureSkipVerify {
+		t.Error("the previous transport was modified")
+	}
+
+	if err := SetInsecureSkipVerify(false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := http.Get(srv.URL); err == nil {
+		t.Error("expected a certificate error after turning verification back on")
+	}
+}
@@ -5,14 +5,9 @@ import (
 	"flag"
 	"log"
 
-	"github.com/hashicorp/terraform-plugin-framework/providerserver"
-	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
-	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 
 	framework "github.com/RafaySystems/terraform-provider-rafay/internal/provider"
-	legacySDK "github.com/RafaySystems/terraform-provider-rafay/rafay"
 )
 
 var (
@@ -32,23 +27,8 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	// Upgrade the legacy provider to protocol v6
-	upgradedSdkServer, err := tf5to6server.UpgradeServer(ctx, legacySDK.New(version)().GRPCProvider)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// Create the provider server functions list
-	providers := []func() tfprotov6.ProviderServer{
-		providerserver.NewProtocol6(framework.New(version)()), // terraform-plugin-framework provider
-		func() tfprotov6.ProviderServer {
-			return upgradedSdkServer
-		},
-	}
-
-	// Create a new mux server
-	muxServer, err := tf6muxserver.NewMuxServer(ctx, providers...)
-
+	// Create the mux server of the framework and legacy SDK providers
+	muxServer, err := framework.NewMuxServer(ctx, version)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -0,0 +1,210 @@
+package rafay
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// artifactDigestsKey is the computed attribute recording the content digest
+// of every file:// artifact in a resource's spec. The state otherwise only
+// holds the file names, so without it editing a local values file or
+// manifest would never show up in a plan.
+const artifactDigestsKey = "artifact_digests"
+
+const artifactFilePrefix = "file://"
+
+func artifactDigestsSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "SHA-256 digests of the local file:// artifacts referenced in the spec, keyed by artifact name",
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
+
+// withArtifactDigests adds artifact_digests to r. The digests are computed
+// from the configuration at plan time, so a changed file plans an update, and
+// they are stored after every successful create and update. Read backfills them for resources created before the
+// attribute existed or imported, so upgrading does not plan an update.
+func withArtifactDigests(r *schema.Resource) *schema.Resource {
+	r.Schema[artifactDigestsKey] = artifactDigestsSchema()
+
+	create, read, update := r.CreateContext, r.ReadContext, r.UpdateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, setArtifactDigests(d)...)
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := update(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, setArtifactDigests(d)...)
+	}
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := read(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		if old, ok := d.Get(artifactDigestsKey).(map[string]interface{}); ok && len(old) > 0 {
+			return diags
+		}
+		// Best effort: files may legitimately be absent on the machine
+		// refreshing the state.
+		if digests, err := artifactDigests(d.Get("spec")); err == nil && len(digests) > 0 {
+			if err := d.Set(artifactDigestsKey, digests); err != nil {
+				return append(diags, diag.FromErr(err)...)
+			}
+		}
+		return diags
+	}
+
+	customize := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if customize != nil {
+			if err := customize(ctx, d, m); err != nil {
+				return err
+			}
+		}
+		return customizeArtifactDigests(d)
+	}
+	return r
+}
+
+// customizeArtifactDigests plans the digests of the files named in the spec.
+// When a name is not known until apply, or names a file that does not exist
+// yet, e.g. one rendered by another resource, the digests are left to be
+// computed at apply, which fails if the file is still missing then.
+func customizeArtifactDigests(d *schema.ResourceDiff) error {
+	if artifactNamesUnknown(d.GetRawConfig()) {
+		return d.SetNewComputed(artifactDigestsKey)
+	}
+	digests, err := artifactDigests(d.Get("spec"))
+	if errors.Is(err, fs.ErrNotExist) {
+		return d.SetNewComputed(artifactDigestsKey)
+	}
+	if err != nil {
+		return err
+	}
+	old, _ := d.Get(artifactDigestsKey).(map[string]interface{})
+	if len(old) == 0 && len(digests) == 0 {
+		return nil
+	}
+	if reflect.DeepEqual(old, digests) {
+		return nil
+	}
+	return d.SetNew(artifactDigestsKey, digests)
+}
+
+func setArtifactDigests(d *schema.ResourceData) diag.Diagnostics {
+	digests, err := artifactDigests(d.Get("spec"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set(artifactDigestsKey, digests); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// artifactDigests returns the "sha256:<hex>" digest of every file://
+// artifact named in v, keyed by the name as written in the configuration.
+func artifactDigests(v interface{}) (map[string]interface{}, error) {
+	names := artifactFileNames(v)
+	digests := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		data, err := readArtifactFile(name)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		digests[name] = "sha256:" + hex.EncodeToString(sum[:])
+	}
+	return digests, nil
+}
+
+// artifactNamesUnknown reports whether the spec in config holds a file name,
+// or a block or list that may hold one, whose value is not known yet.
+func artifactNamesUnknown(config cty.Value) bool {
+	if config.IsNull() || !config.IsKnown() || !config.Type().IsObjectType() || !config.Type().HasAttribute("spec") {
+		return false
+	}
+	unknown := false
+	_ = cty.Walk(config.GetAttr("spec"), func(path cty.Path, v cty.Value) (bool, error) {
+		if v.IsKnown() {
+			return true, nil
+		}
+		if !v.Type().IsPrimitiveType() {
+			unknown = true
+		} else if len(path) > 0 {
+			if step, ok := path[len(path)-1].(cty.GetAttrStep); ok && step.Name == "name" {
+				unknown = true
+			}
+		}
+		return false, nil
+	})
+	return unknown
+}
+
+// artifactFileNames walks a spec as returned by Get and returns the sorted
+// names of all file blocks pointing at local files.
+func artifactFileNames(v interface{}) []string {
+	seen := map[string]bool{}
+	var walk func(v interface{})
+	walk = func(v interface{}) {
+		switch t := v.(type) {
+		case []interface{}:
+			for _, e := range t {
+				walk(e)
+			}
+		case *schema.Set:
+			walk(t.List())
+		case map[string]interface{}:
+			for k, e := range t {
+				if s, ok := e.(string); ok && k == "name" && strings.HasPrefix(s, artifactFilePrefix) {
+					seen[s] = true
+					continue
+				}
+				walk(e)
+			}
+		}
+	}
+	walk(v)
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func artifactFilePath(name string) string {
+	return filepath.Join(filepath.Dir("."), strings.TrimPrefix(name, artifactFilePrefix))
+}
+
+// readArtifactFile reads the local file a file:// artifact name points at.
+func readArtifactFile(name string) ([]byte, error) {
+	path := artifactFilePath(name)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read artifact at '%s': %w", path, err)
+	}
+	return data, nil
+}
@@ -0,0 +1,111 @@
+package rafay
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+)
+
+func TestArtifactDigests(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.WriteFile("values.yaml", []byte("replicas: 1\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	spec := []interface{}{map[string]interface{}{
+		"artifact": []interface{}{map[string]interface{}{
+			"chart_path":   []interface{}{map[string]interface{}{"name": "file://values.yaml"}},
+			"values_paths": []interface{}{map[string]interface{}{"name": "file://values.yaml"}},
+			"repository":   "release",
+			"paths":        []interface{}{map[string]interface{}{"name": "charts/app.tgz"}},
+		}},
+	}}
+
+	if got := artifactFileNames(spec); !reflect.DeepEqual(got, []string{"file://values.yaml"}) {
+		t.Fatalf("artifactFileNames = %v", got)
+	}
+
+	before, err := artifactDigests(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(before["file://values.yaml"].(string), "sha256:") {
+		t.Fatalf("unexpected digest %v", before)
+	}
+
+	if err := os.WriteFile("values.yaml", []byte("replicas: 2\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	after, err := artifactDigests(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(before, after) {
+		t.Error("digest did not change with the file content")
+	}
+
+	if err := os.Remove("values.yaml"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := artifactDigests(spec); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected a not exist error for a missing artifact, got %v", err)
+	}
+}
+
+func TestArtifactNamesUnknown(t *testing.T) {
+	file := func(name cty.Value) cty.Value {
+		return cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{"name": name})})
+	}
+	config := func(artifact map[string]cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"metadata": cty.ListValEmpty(cty.DynamicPseudoType),
+			"spec": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+				"artifact": cty.ListVal([]cty.Value{cty.ObjectVal(artifact)}),
+			})}),
+		})
+	}
+
+	tests := []struct {
+		name   string
+		config cty.Value
+		want   bool
+	}{
+		{
+			name:   "known names",
+			config: config(map[string]cty.Value{"values_paths": file(cty.StringVal("file://values.yaml")), "repository": cty.UnknownVal(cty.String)}),
+		},
+		{
+			name:   "unknown name",
+			config: config(map[string]cty.Value{"values_paths": file(cty.UnknownVal(cty.String)), "repository": cty.StringVal("release")}),
+			want:   true,
+		},
+		{
+			name: "unknown list of files",
+			config: config(map[string]cty.Value{
+				"values_paths": cty.UnknownVal(cty.List(cty.Object(map[string]cty.Type{"name": cty.String}))),
+				"repository":   cty.StringVal("release"),
+			}),
+			want: true,
+		},
+		{
+			name:   "no spec",
+			config: cty.ObjectVal(map[string]cty.Value{"name": cty.StringVal("x")}),
+		},
+		{
+			name:   "null config",
+			config: cty.NullVal(cty.DynamicPseudoType),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := artifactNamesUnknown(tt.config); got != tt.want {
+				t.Errorf("artifactNamesUnknown() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
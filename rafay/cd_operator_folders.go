@@ -0,0 +1,385 @@
+package rafay
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-yaml/yaml"
+)
+
+// Artifact types the CD operator creates workloads with.
+const (
+	cdArtifactHelm      = "Helm"
+	cdArtifactYaml      = "Yaml"
+	cdArtifactKustomize = "Kustomize"
+)
+
+// cdPlacementFile is the file a folder can carry to place its workload on
+// other clusters than the ones configured for the workload.
+const cdPlacementFile = ".rafay-placement.yaml"
+
+var kustomizationFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+// cdPlacement is the content of a cdPlacementFile. It replaces the
+// cluster_names and placement_labels of the workload for the folder.
+type cdPlacement struct {
+	ClusterNames    string            `yaml:"cluster_names"`
+	PlacementLabels map[string]string `yaml:"placement_labels"`
+}
+
+// cdFolder is a repository folder the CD operator deploys a workload from.
+type cdFolder struct {
+	Dir        string
+	Type       string   // one of the cdArtifact types
+	ChartPath  string   // Helm: the chart, empty when sourced elsewhere
+	ValuePaths []string // Helm: the values files
+	Manifests  []string // Yaml: the manifests
+	Archive    string   // Kustomize: the archive built by prepare
+	Overlay    string   // Kustomize: the folder's path inside Archive
+
+	placement     *cdPlacement
+	placementPath string
+}
+
+// classifyCDFolder works out what folder deploys: a Kustomize overlay if it
+// has a kustomization file, a Helm chart if the workload has a chart for
+// it, or plain manifests for workloads that do not name a Helm chart. It
+// returns nil for folders with nothing to deploy.
+func classifyCDFolder(folder string, workload *Workload, baseChart string, baseValues []string) (*cdFolder, error) {
+	f := &cdFolder{Dir: folder}
+	if err := f.readPlacement(); err != nil {
+		return nil, err
+	}
+
+	for _, name := range kustomizationFiles {
+		if _, err := os.Stat(filepath.Join(folder, name)); err == nil {
+			f.Type = cdArtifactKustomize
+			return f, nil
+		}
+	}
+
+	yamls, err := getValuesInFolder(folder)
+	if err != nil {
+		return nil, err
+	}
+	yamls = f.withoutPlacement(yamls)
+
+	chartPath, _ := getChartInFolder(folder)
+	if chartPath == "" {
+		chartPath = baseChart
+	}
+	if chartPath != "" || workload.ChartCatalogName != "" || workload.ChartHelmRepoName != "" || workload.ChartGitRepoName != "" {
+		f.Type = cdArtifactHelm
+		f.ChartPath = chartPath
+		if workload.IncludeBaseValue {
+			f.ValuePaths = append(f.ValuePaths, baseValues...)
+		}
+		f.ValuePaths = append(f.ValuePaths, yamls...)
+		if len(f.ValuePaths) == 0 {
+			return nil, nil
+		}
+		return f, nil
+	}
+
+	// the values of a Helm workload whose chart is missing are not manifests
+	if len(yamls) == 0 || workload.HelmChartName != "" {
+		return nil, nil
+	}
+	f.Type = cdArtifactYaml
+	f.Manifests = yamls
+	return f, nil
+}
+
+func (f *cdFolder) readPlacement() error {
+	path := filepath.Join(f.Dir, cdPlacementFile)
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	p := &cdPlacement{}
+	if err := yaml.Unmarshal(data, p); err != nil {
+		return fmt.Errorf("invalid %s: %w", path, err)
+	}
+	f.placement, f.placementPath = p, path
+	return nil
+}
+
+func (f *cdFolder) withoutPlacement(paths []string) []string {
+	out := paths[:0]
+	for _, p := range paths {
+		if filepath.Base(p) != cdPlacementFile {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// clusterNames returns the cluster names to place the folder's workload on.
+func (f *cdFolder) clusterNames(workload *Workload) string {
+	if f.placement != nil {
+		return f.placement.ClusterNames
+	}
+	return workload.ClusterNames
+}
+
+// placementLabels returns the cluster labels to place the folder's workload by.
+func (f *cdFolder) placementLabels(workload *Workload) map[string]string {
+	if f.placement != nil {
+		return f.placement.PlacementLabels
+	}
+	return workload.PlacementLabels
+}
+
+// placementVersion returns the version of the folder's placement file, or
+// an empty string when it has none, so that placement changes redeploy.
+func (f *cdFolder) placementVersion(r *cdRepo) (string, error) {
+	if f.placementPath == "" {
+		return "", nil
+	}
+	v, err := r.pathVersion(f.placementPath)
+	if err != nil {
+		return "", err
+	}
+	return "." + v, nil
+}
+
+// prepare returns the content version of a Yaml or Kustomize folder. For
+// Kustomize it also archives everything the overlay refers to.
+func (f *cdFolder) prepare(r *cdRepo) (string, error) {
+	switch f.Type {
+	case cdArtifactYaml:
+		var version string
+		for _, m := range f.Manifests {
+			v, err := r.pathVersion(m)
+			if err != nil {
+				return "", err
+			}
+			version += "." + v
+		}
+		return version, nil
+	case cdArtifactKustomize:
+		root, err := kustomizeRoot(r.dir, f.Dir)
+		if err != nil {
+			return "", err
+		}
+		if f.Overlay, err = filepath.Rel(root, f.Dir); err != nil {
+			return "", err
+		}
+		f.Overlay = filepath.ToSlash(f.Overlay)
+		scratch, err := r.scratchDir()
+		if err != nil {
+			return "", err
+		}
+		if f.Archive, err = archiveDir(root, scratch); err != nil {
+			return "", err
+		}
+		v, err := r.dirVersion(root)
+		if err != nil {
+			return "", err
+		}
+		return v + ":" + f.Overlay, nil
+	}
+	return "", fmt.Errorf("unsupported artifact type %s", f.Type)
+}
+
+// kustomization lists the fields of a kustomization file that refer to
+// other files.
+type kustomization struct {
+	Resources             []string `yaml:"resources"`
+	Bases                 []string `yaml:"bases"`
+	Components            []string `yaml:"components"`
+	Crds                  []string `yaml:"crds"`
+	PatchesStrategicMerge []string `yaml:"patchesStrategicMerge"`
+	Patches               []struct {
+		Path string `yaml:"path"`
+	} `yaml:"patches"`
+	ConfigMapGenerator []kustomizeGenerator `yaml:"configMapGenerator"`
+	SecretGenerator    []kustomizeGenerator `yaml:"secretGenerator"`
+}
+
+type kustomizeGenerator struct {
+	Files []string `yaml:"files"`
+	Envs  []string `yaml:"envs"`
+}
+
+func (k *kustomization) paths() []string {
+	var paths []string
+	paths = append(paths, k.Resources...)
+	paths = append(paths, k.Bases...)
+	paths = append(paths, k.Components...)
+	paths = append(paths, k.Crds...)
+	paths = append(paths, k.PatchesStrategicMerge...)
+	for _, p := range k.Patches {
+		if p.Path != "" {
+			paths = append(paths, p.Path)
+		}
+	}
+	for _, g := range append(k.ConfigMapGenerator, k.SecretGenerator...) {
+		for _, file := range g.Files {
+			// files may be given as key=path
+			if i := strings.Index(file, "="); i >= 0 {
+				file = file[i+1:]
+			}
+			paths = append(paths, file)
+		}
+		paths = append(paths, g.Envs...)
+	}
+	return paths
+}
+
+func readKustomization(dir string) (*kustomization, error) {
+	for _, name := range kustomizationFiles {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		k := &kustomization{}
+		if err := yaml.Unmarshal(data, k); err != nil {
+			return nil, fmt.Errorf("invalid kustomization in %s: %w", dir, err)
+		}
+		return k, nil
+	}
+	return nil, nil
+}
+
+// isRemoteKustomizePath reports whether a kustomization entry points at a
+// remote base that kustomize fetches itself.
+func isRemoteKustomizePath(p string) bool {
+	return strings.Contains(p, "://") || strings.HasPrefix(p, "github.com/") || strings.HasPrefix(p, "git@")
+}
+
+// kustomizeRoot returns the deepest folder of the repository at repoDir
+// that holds the overlay at dir and every local base, component and file it
+// refers to, directly or through its bases.
+func kustomizeRoot(repoDir, dir string) (string, error) {
+	root := dir
+	visited := map[string]bool{}
+	var visit func(dir string) error
+	visit = func(dir string) error {
+		if visited[dir] {
+			return nil
+		}
+		visited[dir] = true
+		root = commonDir(root, dir)
+		k, err := readKustomization(dir)
+		if err != nil || k == nil {
+			return err
+		}
+		for _, ref := range k.paths() {
+			if isRemoteKustomizePath(ref) {
+				continue
+			}
+			p := filepath.Join(dir, ref)
+			if rel, err := filepath.Rel(repoDir, p); err != nil || strings.HasPrefix(rel, "..") {
+				return fmt.Errorf("kustomization in %s refers to %s outside the repository", dir, ref)
+			}
+			info, err := os.Stat(p)
+			if err != nil {
+				return fmt.Errorf("kustomization in %s: %w", dir, err)
+			}
+			if !info.IsDir() {
+				root = commonDir(root, filepath.Dir(p))
+				continue
+			}
+			if err := visit(p); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := visit(filepath.Clean(dir)); err != nil {
+		return "", err
+	}
+	return root, nil
+}
+
+// commonDir returns the deepest folder containing both a and b.
+func commonDir(a, b string) string {
+	for {
+		if rel, err := filepath.Rel(a, b); err == nil && !strings.HasPrefix(rel, "..") {
+			return a
+		}
+		parent := filepath.Dir(a)
+		if parent == a {
+			return a
+		}
+		a = parent
+	}
+}
+
+// archiveDir writes the regular files under dir to a gzipped tarball in
+// dest, with names relative to dir, and returns its path.
+func archiveDir(dir, dest string) (string, error) {
+	out, err := os.CreateTemp(dest, "kustomize-*.tar.gz")
+	if err != nil {
+		return "", err
+	}
+	defer out.Close()
+
+	gz := gzip.NewWriter(out)
+	tw := tar.NewWriter(gz)
+	var names []string
+	err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.Mode().IsRegular() {
+			names = append(names, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(names)
+	for _, path := range names {
+		if err := addToArchive(tw, dir, path); err != nil {
+			return "", err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return "", err
+	}
+	if err := gz.Close(); err != nil {
+		return "", err
+	}
+	return out.Name(), out.Close()
+}
+
+func addToArchive(tw *tar.Writer, dir, path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return err
+	}
+	hdr, err := tar.FileInfoHeader(info, "")
+	if err != nil {
+		return err
+	}
+	hdr.Name = filepath.ToSlash(rel)
+	if err := tw.WriteHeader(hdr); err != nil {
+		return err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = io.Copy(tw, f)
+	return err
+}
@@ -0,0 +1,170 @@
+package rafay
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestClassifyCDFolder(t *testing.T) {
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{
+		"apps/kustomize/kustomization.yaml": "resources:\n- deploy.yaml\n",
+		"apps/kustomize/deploy.yaml":        "kind: Deployment\n",
+		"apps/manifests/deploy.yaml":        "kind: Deployment\n",
+		"apps/manifests/" + cdPlacementFile: "cluster_names: east\nplacement_labels:\n  env: prod\n",
+		"apps/chart/web-1.0.0.tgz":          "chart",
+		"apps/chart/values.yaml":            "replicas: 1\n",
+		"apps/empty/README.md":              "nothing here",
+	})
+	workload := &Workload{Name: "web", ClusterNames: "west"}
+
+	tests := []struct {
+		folder string
+		want   string
+	}{
+		{"apps/kustomize", cdArtifactKustomize},
+		{"apps/manifests", cdArtifactYaml},
+		{"apps/chart", cdArtifactHelm},
+		{"apps/empty", ""},
+	}
+	for _, tt := range tests {
+		f, err := classifyCDFolder(filepath.Join(repo, tt.folder), workload, "", nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.folder, err)
+		}
+		var got string
+		if f != nil {
+			got = f.Type
+		}
+		if got != tt.want {
+			t.Errorf("%s: got type %q, want %q", tt.folder, got, tt.want)
+		}
+	}
+
+	f, err := classifyCDFolder(filepath.Join(repo, "apps/manifests"), workload, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(f.Manifests) != 1 || filepath.Base(f.Manifests[0]) != "deploy.yaml" {
+		t.Errorf("the placement file should not be deployed, got %v", f.Manifests)
+	}
+	if f.clusterNames(workload) != "east" || f.placementLabels(workload)["env"] != "prod" {
+		t.Error("the placement file should override the workload placement")
+	}
+
+	helm := &Workload{Name: "web", HelmChartName: "web"}
+	if f, err := classifyCDFolder(filepath.Join(repo, "apps/manifests"), helm, "", nil); err != nil || f != nil {
+		t.Errorf("values without a chart should not deploy as manifests, got %+v %v", f, err)
+	}
+
+	f, err = classifyCDFolder(filepath.Join(repo, "apps/chart"), workload, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.clusterNames(workload) != "west" {
+		t.Error("the workload placement should apply without a placement file")
+	}
+}
+
+func TestKustomizeRoot(t *testing.T) {
+	repo := t.TempDir()
+	writeFiles(t, repo, map[string]string{
+		"kustomize/base/kustomization.yaml":              "resources:\n- deploy.yaml\n",
+		"kustomize/base/deploy.yaml":                     "kind: Deployment\n",
+		"kustomize/overlays/prod/kustomization.yaml":     "resources:\n- ../../base\n- github.com/example/remote?ref=v1\npatches:\n- path: patch.yaml\n",
+		"kustomize/overlays/prod/patch.yaml":             "kind: Deployment\n",
+		"kustomize/overlays/staging/kustomization.yaml":  "resources:\n- deploy.yaml\n",
+		"kustomize/overlays/staging/deploy.yaml":         "kind: Deployment\n",
+		"kustomize/overlays/outside/kustomization.yaml":  "resources:\n- ../../../../elsewhere\n",
+		"kustomize/overlays/missing/kustomization.yaml":  "resources:\n- ../../nope\n",
+		"kustomize/overlays/invalid/kustomization.yaml":  "resources: [\n",
+		"kustomize/overlays/generated/kustomization.yml": "configMapGenerator:\n- name: cfg\n  files:\n  - app.properties=../../config/app.properties\n",
+		"kustomize/config/app.properties":                "a=b\n",
+	})
+	dir := func(name string) string { return filepath.Join(repo, name) }
+
+	tests := []struct {
+		overlay string
+		want    string
+	}{
+		{"kustomize/overlays/prod", "kustomize"},
+		{"kustomize/overlays/staging", "kustomize/overlays/staging"},
+		{"kustomize/overlays/generated", "kustomize"},
+	}
+	for _, tt := range tests {
+		got, err := kustomizeRoot(repo, dir(tt.overlay))
+		if err != nil {
+			t.Fatalf("%s: %v", tt.overlay, err)
+		}
+		if got != dir(tt.want) {
+			t.Errorf("%s: got root %s, want %s", tt.overlay, got, dir(tt.want))
+		}
+	}
+	for _, overlay := range []string{"kustomize/overlays/outside", "kustomize/overlays/missing", "kustomize/overlays/invalid"} {
+		if _, err := kustomizeRoot(repo, dir(overlay)); err == nil {
+			t.Errorf("%s: expected an error", overlay)
+		}
+	}
+}
+
+func TestArchiveDir(t *testing.T) {
+	src := t.TempDir()
+	writeFiles(t, src, map[string]string{
+		"base/kustomization.yaml":          "resources:\n- deploy.yaml\n",
+		"base/deploy.yaml":                 "kind: Deployment\n",
+		"overlays/prod/kustomization.yaml": "resources:\n- ../../base\n",
+	})
+
+	path, err := archiveDir(src, t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	gz, err := gzip.NewReader(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr := tar.NewReader(gz)
+	var names []string
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, hdr.Name)
+	}
+	want := []string{"base/deploy.yaml", "base/kustomization.yaml", "overlays/prod/kustomization.yaml"}
+	if !sort.StringsAreSorted(names) || len(names) != len(want) {
+		t.Fatalf("got %v, want %v", names, want)
+	}
+	for i := range want {
+		if names[i] != want[i] {
+			t.Fatalf("got %v, want %v", names, want)
+		}
+	}
+}
@@ -0,0 +1,270 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	githttp "github.com/go-git/go-git/v5/plumbing/transport/http"
+	gitssh "github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	"github.com/go-git/go-git/v5/storage/memory"
+	"golang.org/x/crypto/ssh"
+)
+
+// cdCheckoutRef is where the commit being deployed is fetched to.
+const cdCheckoutRef = "refs/tfcd/checkout"
+
+// legacyCDRepoLocalPath is the repo_local_path every resource got by default
+// before checkouts went to temporary directories.
+const legacyCDRepoLocalPath = "/tmp/apprepo"
+
+// cdRepo is a checkout of the repository a rafay_workload_cd_operator
+// deploys from. The git objects are kept in memory; only the worktree is
+// written to disk, since workloads reference their charts and values as
+// file:// artifacts.
+type cdRepo struct {
+	dir     string
+	mu      sync.Mutex // guards tree, which is not safe for concurrent lookups, and scratch
+	scratch string     // holds artifacts built from the checkout
+	commit  plumbing.Hash
+	tree    *object.Tree
+}
+
+// cdRepoAuth returns the credentials for the repository: an SSH key when
+// one is configured, otherwise basic auth with the password or token.
+func cdRepoAuth(spec *WorkloadCDConfigSpec) (transport.AuthMethod, error) {
+	creds := spec.Credentials
+	if creds == nil {
+		return nil, nil
+	}
+	if creds.PrivateKey != "" {
+		user := creds.Username
+		if m := sshURLRegex.FindStringSubmatch(spec.RepoURL); m != nil && m[2] != "" {
+			user = m[2]
+		}
+		keys, err := gitssh.NewPublicKeys(user, []byte(creds.PrivateKey), "")
+		if err != nil {
+			return nil, fmt.Errorf("invalid repository private key: %w", err)
+		}
+		if spec.Insecure {
+			keys.HostKeyCallback = ssh.InsecureIgnoreHostKey()
+		}
+		return keys, nil
+	}
+	password := creds.Password
+	if password == "" {
+		password = creds.Token
+	}
+	if password == "" {
+		return nil, nil
+	}
+	return &githttp.BasicAuth{Username: creds.Username, Password: password}, nil
+}
+
+func newCDRemote(storer *memory.Storage, url string) *git.Remote {
+	return git.NewRemote(storer, &config.RemoteConfig{Name: git.DefaultRemoteName, URLs: []string{url}})
+}
+
+// resolveCDRepoCommit returns the commit the operator would deploy: the
+// pinned repo_commit if set, otherwise the head of repo_branch, or of the
+// default branch when no branch is set.
+func resolveCDRepoCommit(ctx context.Context, spec *WorkloadCDConfigSpec) (string, error) {
+	if spec.RepoCommit != "" {
+		return spec.RepoCommit, nil
+	}
+	auth, err := cdRepoAuth(spec)
+	if err != nil {
+		return "", err
+	}
+	refs, err := newCDRemote(memory.NewStorage(), spec.RepoURL).ListContext(ctx, &git.ListOptions{
+		Auth:            auth,
+		InsecureSkipTLS: spec.Insecure,
+	})
+	if err != nil {
+		return "", fmt.Errorf("unable to list references of %s: %w", spec.RepoURL, err)
+	}
+	byName := make(map[plumbing.ReferenceName]*plumbing.Reference, len(refs))
+	for _, ref := range refs {
+		byName[ref.Name()] = ref
+	}
+	want := plumbing.HEAD
+	if spec.RepoBranch != "" {
+		want = plumbing.NewBranchReferenceName(spec.RepoBranch)
+	}
+	ref := byName[want]
+	if ref != nil && ref.Type() == plumbing.SymbolicReference {
+		ref = byName[ref.Target()]
+	}
+	if ref == nil {
+		return "", fmt.Errorf("%s has no reference %s", spec.RepoURL, want)
+	}
+	return ref.Hash().String(), nil
+}
+
+// checkoutCDRepo checks out commit, or the commit resolveCDRepoCommit
+// returns when it is empty, with a shallow fetch. The worktree goes to a new
+// directory of its own, under repo_local_path if set and the system
+// temporary directory otherwise, so operators running in parallel do not
+// share a checkout and nothing the provider did not create is replaced.
+func checkoutCDRepo(ctx context.Context, spec *WorkloadCDConfigSpec, commit string) (*cdRepo, error) {
+	if commit == "" {
+		var err error
+		if commit, err = resolveCDRepoCommit(ctx, spec); err != nil {
+			return nil, err
+		}
+	}
+	auth, err := cdRepoAuth(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.RepositoryLocalPath != "" {
+		if err := os.MkdirAll(spec.RepositoryLocalPath, 0o700); err != nil {
+			return nil, err
+		}
+	}
+	r := &cdRepo{commit: plumbing.NewHash(commit)}
+	if r.dir, err = os.MkdirTemp(spec.RepositoryLocalPath, "tfcd-"); err != nil {
+		return nil, err
+	}
+	if err := r.checkout(ctx, spec, auth); err != nil {
+		r.close()
+		return nil, err
+	}
+	log.Println("checked out", spec.RepoURL, "at", commit, "to", r.dir)
+	return r, nil
+}
+
+func (r *cdRepo) checkout(ctx context.Context, spec *WorkloadCDConfigSpec, auth transport.AuthMethod) error {
+	storer := memory.NewStorage()
+	repo, err := git.Init(storer, osfs.New(r.dir))
+	if err != nil {
+		return err
+	}
+	remote := newCDRemote(storer, spec.RepoURL)
+	fetch := func(refSpec config.RefSpec, depth int) error {
+		err := remote.FetchContext(ctx, &git.FetchOptions{
+			RefSpecs:        []config.RefSpec{refSpec},
+			Depth:           depth,
+			Auth:            auth,
+			InsecureSkipTLS: spec.Insecure,
+			Tags:            git.NoTags,
+		})
+		if errors.Is(err, git.NoErrAlreadyUpToDate) {
+			return nil
+		}
+		return err
+	}
+
+	// The commit is usually the tip of the branch, which every server
+	// serves shallow. A pinned commit further back is fetched by SHA where
+	// the server allows it, and looked for in the branch history otherwise.
+	src := plumbing.HEAD.String()
+	if spec.RepoBranch != "" {
+		src = plumbing.NewBranchReferenceName(spec.RepoBranch).String()
+	}
+	attempts := []struct {
+		refSpec config.RefSpec
+		depth   int
+	}{
+		{config.RefSpec("+" + src + ":" + cdCheckoutRef), 1},
+		{config.RefSpec(fmt.Sprintf("+%s:%s", r.commit, cdCheckoutRef)), 1},
+		{config.RefSpec("+" + src + ":" + cdCheckoutRef), 0},
+	}
+	var c *object.Commit
+	var errs []error
+	for _, a := range attempts {
+		if err := fetch(a.refSpec, a.depth); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if c, err = repo.CommitObject(r.commit); err == nil {
+			break
+		}
+	}
+	if c == nil {
+		return fmt.Errorf("unable to fetch %s from %s: %w", r.commit, spec.RepoURL, errors.Join(append(errs, err)...))
+	}
+
+	wt, err := repo.Worktree()
+	if err != nil {
+		return err
+	}
+	if err := wt.Checkout(&git.CheckoutOptions{Hash: r.commit, Force: true}); err != nil {
+		return fmt.Errorf("unable to check out %s: %w", r.commit, err)
+	}
+	r.tree, err = c.Tree()
+	return err
+}
+
+// pathVersion returns a short version for the file at path in the
+// worktree. It is the hash of the file's content, so it only changes when
+// the file does, regardless of how many commits the shallow checkout lacks.
+func (r *cdRepo) pathVersion(path string) (string, error) {
+	rel, err := filepath.Rel(r.dir, path)
+	if err != nil {
+		return "", err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f, err := r.tree.File(filepath.ToSlash(rel))
+	if err != nil {
+		return "", fmt.Errorf("%s is not tracked at %s: %w", rel, r.commit, err)
+	}
+	return f.Hash.String()[:7], nil
+}
+
+// dirVersion is pathVersion for a folder: the hash of the folder's tree.
+func (r *cdRepo) dirVersion(path string) (string, error) {
+	rel, err := filepath.Rel(r.dir, path)
+	if err != nil {
+		return "", err
+	}
+	if rel == "." {
+		return r.tree.Hash.String()[:7], nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	t, err := r.tree.Tree(filepath.ToSlash(rel))
+	if err != nil {
+		return "", fmt.Errorf("%s is not tracked at %s: %w", rel, r.commit, err)
+	}
+	return t.Hash.String()[:7], nil
+}
+
+// scratchDir returns a temporary directory, outside the worktree, for
+// artifacts built from the checkout. It is removed by close.
+func (r *cdRepo) scratchDir() (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.scratch == "" {
+		dir, err := os.MkdirTemp("", "tfcd-artifacts-")
+		if err != nil {
+			return "", err
+		}
+		r.scratch = dir
+	}
+	return r.scratch, nil
+}
+
+// close removes the worktree and the artifacts built from it.
+func (r *cdRepo) close() {
+	for _, dir := range []string{r.dir, r.scratch} {
+		if dir == "" {
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			log.Println("failed to remove", dir, err)
+		}
+	}
+}
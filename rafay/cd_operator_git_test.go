@@ -0,0 +1,149 @@
+package rafay
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/file"
+	"github.com/go-git/go-git/v5/plumbing/transport/server"
+)
+
+func commitFiles(t *testing.T, dir string, files map[string]string) string {
+	t.Helper()
+	repo, err := git.PlainOpen(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wt, err := repo.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := wt.Add(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	hash, err := wt.Commit("update", &git.CommitOptions{
+		Author: &object.Signature{Name: "test", Email: "test@example.com", When: time.Now()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash.String()
+}
+
+// serveTestRepo creates an empty repository and returns its directory and
+// its file:// URL.
+func serveTestRepo(t *testing.T) (string, string) {
+	t.Helper()
+	upstream := t.TempDir()
+	repo, err := git.PlainInit(upstream, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := "file://" + upstream
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Serve the repository in process rather than through git-upload-pack.
+	client.InstallProtocol("file", server.NewServer(server.MapLoader{ep.String(): repo.Storer}))
+	t.Cleanup(func() { client.InstallProtocol("file", file.DefaultClient) })
+	return upstream, url
+}
+
+func TestCheckoutCDRepo(t *testing.T) {
+	upstream, url := serveTestRepo(t)
+
+	first := commitFiles(t, upstream, map[string]string{
+		"apps/prod/web/values.yaml": "replicas: 1\n",
+		"apps/prod/api/values.yaml": "replicas: 1\n",
+	})
+	second := commitFiles(t, upstream, map[string]string{
+		"apps/prod/web/values.yaml": "replicas: 2\n",
+	})
+
+	ctx := context.Background()
+	spec := &WorkloadCDConfigSpec{RepoURL: url}
+	head, err := resolveCDRepoCommit(ctx, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if head != second {
+		t.Fatalf("resolved %s, want the head commit %s", head, second)
+	}
+
+	checkout := func(commit string) *cdRepo {
+		r, err := checkoutCDRepo(ctx, spec, commit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(r.close)
+		return r
+	}
+	latest := checkout("")
+	if latest.commit.String() != second {
+		t.Fatalf("checked out %s, want %s", latest.commit, second)
+	}
+	data, err := os.ReadFile(filepath.Join(latest.dir, "apps/prod/web/values.yaml"))
+	if err != nil || string(data) != "replicas: 2\n" {
+		t.Fatalf("got %q %v", data, err)
+	}
+
+	pinned := checkout(first)
+	version := func(r *cdRepo, name string) string {
+		v, err := r.pathVersion(filepath.Join(r.dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return v
+	}
+	if version(latest, "apps/prod/web/values.yaml") == version(pinned, "apps/prod/web/values.yaml") {
+		t.Error("version of a changed file did not change")
+	}
+	if version(latest, "apps/prod/api/values.yaml") != version(pinned, "apps/prod/api/values.yaml") {
+		t.Error("version of an unchanged file changed")
+	}
+
+	if latest.dir == pinned.dir {
+		t.Error("checkouts share a directory")
+	}
+	latest.close()
+	if _, err := os.Stat(latest.dir); !os.IsNotExist(err) {
+		t.Errorf("temporary checkout not removed: %v", err)
+	}
+
+	// A repo_local_path gets a checkout of its own under it; what was
+	// there before is left alone.
+	parent := t.TempDir()
+	kept := filepath.Join(parent, "keep.txt")
+	if err := os.WriteFile(kept, []byte("mine"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	spec.RepositoryLocalPath = parent
+	local := checkout("")
+	if filepath.Dir(local.dir) != parent {
+		t.Errorf("checked out to %s, want a directory under %s", local.dir, parent)
+	}
+	local.close()
+	if _, err := os.Stat(local.dir); !os.IsNotExist(err) {
+		t.Errorf("checkout under repo_local_path not removed: %v", err)
+	}
+	if _, err := os.Stat(kept); err != nil {
+		t.Errorf("file in repo_local_path removed: %v", err)
+	}
+}
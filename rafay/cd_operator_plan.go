@@ -0,0 +1,177 @@
+package rafay
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/RafaySystems/rafay-common/proto/types/hub/appspb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Actions of a cdWorkloadChange. Deletes take the delete_action of the
+// workload, delete or unpublish.
+const (
+	cdChangeCreate = "create"
+	cdChangeUpdate = "update"
+)
+
+// cdWorkloadChange is a workload an apply of the operator creates, updates,
+// deletes or unpublishes.
+type cdWorkloadChange struct {
+	Project      string
+	Namespace    string
+	WorkloadName string
+	RepoFolder   string
+	Action       string
+}
+
+// planCDWorkloadChanges works out the changes an apply of cfg makes to the
+// operator's workloads in existing, the way processApplicationFolders and
+// processApplicationFoldersForDelete make them, without changing anything.
+// Workloads already at the version of their folder are left out.
+func planCDWorkloadChanges(cfg *WorkloadCDConfig, existing *appspb.WorkloadList) ([]*cdWorkloadChange, error) {
+	var changes []*cdWorkloadChange
+	for _, workload := range cfg.Spec.Workloads {
+		folders, _, baseChart, baseValues, err := walkRepo(cfg, workload)
+		if err != nil {
+			return nil, err
+		}
+
+		// the project/namespace pairs the workload is kept in
+		kept := map[string]bool{}
+		for _, folder := range folders {
+			project, namespace, workloadName := matchCDFolder(cfg, workload, folder)
+			if workloadName != workload.Name {
+				continue
+			}
+			f, err := classifyCDFolder(folder, workload, baseChart, baseValues)
+			if err != nil {
+				// the apply neither deploys from nor deletes a broken folder
+				log.Println("planCDWorkloadChanges ignore folder", folder, "error", err)
+				kept[project+"/"+namespace] = true
+				continue
+			}
+			if f == nil {
+				continue
+			}
+			kept[project+"/"+namespace] = true
+			if project == "" || namespace == "" {
+				continue
+			}
+
+			version, err := cdWorkloadVersion(cfg, workload, f)
+			if err != nil {
+				return nil, fmt.Errorf("unable to deploy %s: %w", folder, err)
+			}
+			action := cdChangeCreate
+			if w := findCDWorkload(existing, project, workload.Name); w != nil {
+				if w.Spec.Version == version {
+					continue
+				}
+				action = cdChangeUpdate
+			}
+			changes = append(changes, &cdWorkloadChange{
+				Project:      project,
+				Namespace:    namespace,
+				WorkloadName: workload.Name,
+				RepoFolder:   folder,
+				Action:       action,
+			})
+		}
+
+		if workload.DeleteAction != "delete" && workload.DeleteAction != "unpublish" {
+			continue
+		}
+		for _, w := range existing.Items {
+			if w.Metadata.Name != workload.Name || kept[w.Metadata.Project+"/"+w.Spec.Namespace] {
+				continue
+			}
+			changes = append(changes, &cdWorkloadChange{
+				Project:      w.Metadata.Project,
+				Namespace:    w.Spec.Namespace,
+				WorkloadName: w.Metadata.Name,
+				Action:       workload.DeleteAction,
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		a, b := changes[i], changes[j]
+		if a.Project != b.Project {
+			return a.Project < b.Project
+		}
+		if a.WorkloadName != b.WorkloadName {
+			return a.WorkloadName < b.WorkloadName
+		}
+		return a.Namespace < b.Namespace
+	})
+	return changes, nil
+}
+
+func findCDWorkload(list *appspb.WorkloadList, project, name string) *appspb.Workload {
+	for _, w := range list.Items {
+		if w.Metadata.Project == project && w.Metadata.Name == name {
+			return w
+		}
+	}
+	return nil
+}
+
+func flattenCDWorkloadChanges(changes []*cdWorkloadChange) []interface{} {
+	out := make([]interface{}, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, map[string]interface{}{
+			"project":       c.Project,
+			"namespace":     c.Namespace,
+			"workload_name": c.WorkloadName,
+			"repo_folder":   c.RepoFolder,
+			"action":        c.Action,
+		})
+	}
+	return out
+}
+
+// resourceWorkloadCDOperatorPlanDiff previews in workload_changes what an
+// apply does to the workloads, from the commit in resolved_commit.
+func resourceWorkloadCDOperatorPlanDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	for _, k := range []string{"spec", "spec.0.workload", "resolved_commit"} {
+		if !d.NewValueKnown(k) {
+			return d.SetNewComputed("workload_changes")
+		}
+	}
+	v, ok := d.Get("spec").([]interface{})
+	if !ok || len(v) == 0 {
+		return nil
+	}
+	spec, err := expandWorkloadCDConfigSpec(v)
+	if err != nil {
+		return err
+	}
+
+	// Plan from a checkout of its own: repo_local_path is only written to
+	// by the apply.
+	spec.RepositoryLocalPath = ""
+	repo, err := checkoutCDRepo(ctx, spec, d.Get("resolved_commit").(string))
+	if err != nil {
+		return err
+	}
+	defer repo.close()
+	spec.RepositoryLocalPath = repo.dir
+	cfg := &WorkloadCDConfig{Spec: spec, repo: repo}
+
+	client, err := getHubClient(m)
+	if err != nil {
+		return err
+	}
+	existing, err := listCDWorkloads(ctx, client, spec.MaxParallel)
+	if err != nil {
+		return fmt.Errorf("unable to list workloads: %w", err)
+	}
+	changes, err := planCDWorkloadChanges(cfg, existing)
+	if err != nil {
+		return err
+	}
+	return d.SetNew("workload_changes", flattenCDWorkloadChanges(changes))
+}
@@ -0,0 +1,79 @@
+package rafay
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/proto/types/hub/appspb"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
+)
+
+func TestPlanCDWorkloadChanges(t *testing.T) {
+	upstream, url := serveTestRepo(t)
+	commitFiles(t, upstream, map[string]string{
+		"apps/p1/web/ns1/deploy.yaml": "kind: Deployment\n",
+		"apps/p2/web/ns1/deploy.yaml": "kind: Deployment\n",
+		"apps/p4/web/ns2/deploy.yaml": "kind: Deployment\n",
+	})
+
+	spec := &WorkloadCDConfigSpec{RepoURL: url}
+	repo, err := checkoutCDRepo(context.Background(), spec, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer repo.close()
+	spec.RepositoryLocalPath = repo.dir
+	web := &Workload{Name: "web", PathMatchPattern: "/apps/:project/:workload/:namespace", DeleteAction: "delete"}
+	spec.Workloads = []*Workload{web}
+	cfg := &WorkloadCDConfig{Spec: spec, repo: repo}
+
+	f, err := classifyCDFolder(filepath.Join(repo.dir, "apps/p1/web/ns1"), web, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	current, err := cdWorkloadVersion(cfg, web, f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	workload := func(project, name, namespace, version string) *appspb.Workload {
+		return &appspb.Workload{
+			Metadata: &commonpb.Metadata{Name: name, Project: project},
+			Spec:     &appspb.WorkloadSpec{Namespace: namespace, Version: version},
+		}
+	}
+	existing := &appspb.WorkloadList{Items: []*appspb.Workload{
+		workload("p1", "web", "ns1", current),
+		workload("p2", "web", "ns1", "stale"),
+		workload("p3", "web", "ns1", current),
+		workload("p3", "api", "ns1", current),
+	}}
+
+	changes, err := planCDWorkloadChanges(cfg, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*cdWorkloadChange{
+		{Project: "p2", Namespace: "ns1", WorkloadName: "web", RepoFolder: filepath.Join(repo.dir, "apps/p2/web/ns1"), Action: cdChangeUpdate},
+		{Project: "p3", Namespace: "ns1", WorkloadName: "web", Action: "delete"},
+		{Project: "p4", Namespace: "ns2", WorkloadName: "web", RepoFolder: filepath.Join(repo.dir, "apps/p4/web/ns2"), Action: cdChangeCreate},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		for _, c := range changes {
+			t.Logf("%+v", *c)
+		}
+		t.Fatal("unexpected changes")
+	}
+
+	web.DeleteAction = "none"
+	changes, err = planCDWorkloadChanges(cfg, existing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range changes {
+		if c.Project == "p3" {
+			t.Errorf("delete_action none should not plan %s of %s", c.Action, c.Project)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Failure modes of the operator.
+const (
+	cdBestEffort = "best_effort" // deploy every folder, reporting failures as warnings
+	cdFailFast   = "fail_fast"   // stop at the first failure, reporting it as an error
+)
+
+const cdDefaultMaxParallel = 10
+
+// cdRun is the state of one apply of the operator shared by the workloads it
+// deploys in parallel.
+type cdRun struct {
+	guard    chan struct{} // bounds the workloads deployed at a time
+	failFast bool
+	rollback bool
+	cancel   context.CancelFunc
+
+	mu       sync.Mutex // guards the fields below and the results of the WorkloadCDConfig
+	failures []*cdWorkloadFailure
+	created  []*WorkloadsUpsert // workloads the run created, for rollback
+}
+
+// cdWorkloadFailure is a workload the operator failed to deploy or delete.
+type cdWorkloadFailure struct {
+	Project      string
+	Namespace    string
+	WorkloadName string
+	RepoFolder   string
+	Err          error
+}
+
+// startCDRun sets up the run of cfg from its spec. The returned context is
+// cancelled at the first failure in fail_fast mode.
+func startCDRun(ctx context.Context, cfg *WorkloadCDConfig) context.Context {
+	spec := cfg.Spec
+	limit := spec.MaxParallel
+	if limit <= 0 {
+		limit = cdDefaultMaxParallel
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	cfg.run = &cdRun{
+		guard:    make(chan struct{}, limit),
+		failFast: spec.FailureMode == cdFailFast,
+		rollback: spec.RollbackOnFailure,
+		cancel:   cancel,
+	}
+	return ctx
+}
+
+// acquire waits for room to deploy another workload. It returns false once
+// the run is stopped.
+func (r *cdRun) acquire(ctx context.Context) bool {
+	select {
+	case r.guard <- struct{}{}:
+	case <-ctx.Done():
+		return false
+	}
+	if ctx.Err() != nil {
+		r.release()
+		return false
+	}
+	return true
+}
+
+func (r *cdRun) release() {
+	<-r.guard
+}
+
+// fail records the failure of a workload and its status, and stops the run
+// in fail_fast mode.
+func (r *cdRun) fail(cfg *WorkloadCDConfig, status *WorkloadCDStatus, err error) {
+	if status.Status == nil {
+		status.Status = &commonpb.Status{}
+	}
+	status.Status.ConditionType = "Failed"
+	status.Status.Reason = err.Error()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cfg.Status = append(cfg.Status, status)
+	if errors.Is(err, context.Canceled) && len(r.failures) > 0 {
+		// stopped by an earlier failure, which is the one to report
+		return
+	}
+	r.failures = append(r.failures, &cdWorkloadFailure{
+		Project:      status.Project,
+		Namespace:    status.Namespace,
+		WorkloadName: status.WorkloadName,
+		RepoFolder:   status.RepoFolder,
+		Err:          err,
+	})
+	if r.failFast {
+		r.cancel()
+	}
+}
+
+func (r *cdRun) addStatus(cfg *WorkloadCDConfig, status *WorkloadCDStatus) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cfg.Status = append(cfg.Status, status)
+}
+
+func (r *cdRun) addUpsert(cfg *WorkloadCDConfig, upsert *WorkloadsUpsert, created bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cfg.Upserts = append(cfg.Upserts, upsert)
+	if created {
+		r.created = append(r.created, upsert)
+	}
+}
+
+func (r *cdRun) addDecommission(cfg *WorkloadCDConfig, decommission *WorkloadsDecommission) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cfg.Decommissions = append(cfg.Decommissions, decommission)
+}
+
+// finish rolls back the workloads the run created if it failed and rollback
+// is enabled, and returns a diagnostic for each failed workload: errors in
+// fail_fast mode, warnings otherwise.
+func (r *cdRun) finish(ctx context.Context, client typed.Client, cfg *WorkloadCDConfig) diag.Diagnostics {
+	r.cancel()
+	var diags diag.Diagnostics
+	if len(r.failures) == 0 {
+		return diags
+	}
+
+	if r.rollback {
+		// the run context may be cancelled already
+		ctx = context.WithoutCancel(ctx)
+		for _, w := range r.created {
+			log.Println("rollback workload", w.Project, w.WorkloadName)
+			err := client.AppsV3().Workload().Delete(ctx, options.DeleteOptions{
+				Name:    w.WorkloadName,
+				Project: w.Project,
+			})
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  fmt.Sprintf("unable to roll back workload %s in project %s", w.WorkloadName, w.Project),
+					Detail:   err.Error(),
+				})
+				continue
+			}
+			cfg.Upserts = removeCDUpsert(cfg.Upserts, w)
+		}
+	}
+
+	severity := diag.Warning
+	if r.failFast {
+		severity = diag.Error
+	}
+	for _, f := range r.failures {
+		detail := f.Err.Error()
+		if f.RepoFolder != "" {
+			detail = fmt.Sprintf("folder %s: %s", f.RepoFolder, detail)
+		}
+		diags = append(diags, diag.Diagnostic{
+			Severity: severity,
+			Summary:  fmt.Sprintf("workload %s in project %s, namespace %s failed", f.WorkloadName, f.Project, f.Namespace),
+			Detail:   detail,
+		})
+	}
+	return diags
+}
+
+func removeCDUpsert(upserts []*WorkloadsUpsert, w *WorkloadsUpsert) []*WorkloadsUpsert {
+	out := upserts[:0]
+	for _, u := range upserts {
+		if u != w {
+			out = append(out, u)
+		}
+	}
+	return out
+}
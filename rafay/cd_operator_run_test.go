@@ -0,0 +1,60 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestCDRunLimit(t *testing.T) {
+	cfg := &WorkloadCDConfig{Spec: &WorkloadCDConfigSpec{MaxParallel: 2}}
+	ctx := startCDRun(context.Background(), cfg)
+	if !cfg.run.acquire(ctx) || !cfg.run.acquire(ctx) {
+		t.Fatal("acquire within the limit failed")
+	}
+	select {
+	case cfg.run.guard <- struct{}{}:
+		t.Fatal("acquired beyond the limit")
+	default:
+	}
+	cfg.run.release()
+	if !cfg.run.acquire(ctx) {
+		t.Fatal("acquire after a release failed")
+	}
+}
+
+func TestCDRunFailureModes(t *testing.T) {
+	for _, tt := range []struct {
+		mode     string
+		stops    bool
+		severity diag.Severity
+	}{
+		{cdBestEffort, false, diag.Warning},
+		{cdFailFast, true, diag.Error},
+	} {
+		cfg := &WorkloadCDConfig{Spec: &WorkloadCDConfigSpec{FailureMode: tt.mode}}
+		ctx := startCDRun(context.Background(), cfg)
+		cfg.run.fail(cfg, &WorkloadCDStatus{Project: "p1", Namespace: "ns1", WorkloadName: "web", RepoFolder: "/repo/p1/web/ns1"}, errors.New("no clusters"))
+		if stopped := ctx.Err() != nil; stopped != tt.stops {
+			t.Errorf("%s: stopped %v, want %v", tt.mode, stopped, tt.stops)
+		}
+		if cfg.run.acquire(ctx) == tt.stops {
+			t.Errorf("%s: acquire after a failure returned %v", tt.mode, !tt.stops)
+		}
+		// workloads cut short by fail_fast are not failures of their own
+		cfg.run.fail(cfg, &WorkloadCDStatus{Project: "p2", WorkloadName: "web"}, context.Canceled)
+
+		if len(cfg.Status) != 2 || cfg.Status[0].Status.ConditionType != "Failed" {
+			t.Errorf("%s: failed workloads should have a Failed status, got %+v", tt.mode, cfg.Status)
+		}
+		diags := cfg.run.finish(context.Background(), nil, cfg)
+		if len(diags) != 1 || diags[0].Severity != tt.severity {
+			t.Fatalf("%s: got diagnostics %+v", tt.mode, diags)
+		}
+		if diags[0].Summary != "workload web in project p1, namespace ns1 failed" || diags[0].Detail != "folder /repo/p1/web/ns1: no clusters" {
+			t.Errorf("%s: got diagnostic %+v", tt.mode, diags[0])
+		}
+	}
+}
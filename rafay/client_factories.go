@@ -0,0 +1,109 @@
+package rafay
+
+import (
+	"context"
+
+	appsv3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/apps/v3"
+	eaasv1 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/eaas/v1"
+	infrav3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
+	systemv3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/system/v3"
+)
+
+// Client factories let tests hand resources a fake typed client through
+// ProviderMeta, the way BlueprintClientFactory does for blueprints. A nil
+// factory means the client comes from the shared hub client, or from the
+// impersonated one when the call carries an impersonation.
+type (
+	NamespaceClientFactory   func() (infrav3.NamespaceClient, error)
+	WorkloadClientFactory    func() (appsv3.WorkloadClient, error)
+	AddonClientFactory       func() (infrav3.AddonClient, error)
+	ProjectClientFactory     func() (systemv3.ProjectClient, error)
+	EnvironmentClientFactory func() (eaasv1.EnvironmentClient, error)
+	CredentialsClientFactory func() (infrav3.CredentialsClient, error)
+	ClusterClientFactory     func() (infrav3.ClusterClient, error)
+)
+
+func providerMeta(m interface{}) *ProviderMeta {
+	meta, _ := m.(*ProviderMeta)
+	return meta
+}
+
+func namespaceClient(ctx context.Context, m interface{}) (infrav3.NamespaceClient, error) {
+	if meta := providerMeta(m); meta != nil && meta.NamespaceClientFactory != nil {
+		return meta.NamespaceClientFactory()
+	}
+	client, err := hubClientFromContext(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return client.InfraV3().Namespace(), nil
+}
+
+func workloadClient(ctx context.Context, m interface{}) (appsv3.WorkloadClient, error) {
+	if meta := providerMeta(m); meta != nil && meta.WorkloadClientFactory != nil {
+		return meta.WorkloadClientFactory()
+	}
+	client, err := hubClientFromContext(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return client.AppsV3().Workload(), nil
+}
+
+func addonClient(ctx context.Context, m interface{}) (infrav3.AddonClient, error) {
+	if meta := providerMeta(m); meta != nil && meta.AddonClientFactory != nil {
+		return meta.AddonClientFactory()
+	}
+	client, err := hubClientFromContext(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return client.InfraV3().Addon(), nil
+}
+
+func projectClient(ctx context.Context, m interface{}) (systemv3.ProjectClient, error) {
+	if meta := providerMeta(m); meta != nil && meta.ProjectClientFactory != nil {
+		return meta.ProjectClientFactory()
+	}
+	client, err := hubClientFromContext(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return client.SystemV3().Project(), nil
+}
+
+func environmentClient(ctx context.Context, m interface{}) (eaasv1.EnvironmentClient, error) {
+	if meta := providerMeta(m); meta != nil && meta.EnvironmentClientFactory != nil {
+		return meta.EnvironmentClientFactory()
+	}
+	client, err := hubClientFromContext(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return client.EaasV1().Environment(), nil
+}
+
+func credentialsClient(ctx context.Context, m interface{}) (infrav3.CredentialsClient, error) {
+	if meta := providerMeta(m); meta != nil && meta.CredentialsClientFactory != nil {
+		return meta.CredentialsClientFactory()
+	}
+	client, err := hubClientFromContext(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return client.InfraV3().Credentials(), nil
+}
+
+// clusterClient serves the hub (v3) cluster resources such as
+// rafay_aks_cluster_v3 and rafay_gke_cluster. Cluster resources built on
+// rctl's cluster package do not go through it.
+func clusterClient(ctx context.Context, m interface{}) (infrav3.ClusterClient, error) {
+	if meta := providerMeta(m); meta != nil && meta.ClusterClientFactory != nil {
+		return meta.ClusterClientFactory()
+	}
+	client, err := hubClientFromContext(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	return client.InfraV3().Cluster(), nil
+}
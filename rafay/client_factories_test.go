@@ -0,0 +1,41 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	appsv3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/apps/v3"
+	eaasv1 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/eaas/v1"
+	infrav3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
+	systemv3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/system/v3"
+)
+
+func TestClientFactoriesTakePrecedence(t *testing.T) {
+	errFactory := errors.New("factory called")
+	meta := &ProviderMeta{
+		NamespaceClientFactory:   func() (infrav3.NamespaceClient, error) { return nil, errFactory },
+		WorkloadClientFactory:    func() (appsv3.WorkloadClient, error) { return nil, errFactory },
+		AddonClientFactory:       func() (infrav3.AddonClient, error) { return nil, errFactory },
+		ProjectClientFactory:     func() (systemv3.ProjectClient, error) { return nil, errFactory },
+		EnvironmentClientFactory: func() (eaasv1.EnvironmentClient, error) { return nil, errFactory },
+		CredentialsClientFactory: func() (infrav3.CredentialsClient, error) { return nil, errFactory },
+		ClusterClientFactory:     func() (infrav3.ClusterClient, error) { return nil, errFactory },
+	}
+
+	ctx := context.Background()
+	accessors := map[string]func() error{
+		"namespace":   func() error { _, err := namespaceClient(ctx, meta); return err },
+		"workload":    func() error { _, err := workloadClient(ctx, meta); return err },
+		"addon":       func() error { _, err := addonClient(ctx, meta); return err },
+		"project":     func() error { _, err := projectClient(ctx, meta); return err },
+		"environment": func() error { _, err := environmentClient(ctx, meta); return err },
+		"credentials": func() error { _, err := credentialsClient(ctx, meta); return err },
+		"cluster":     func() error { _, err := clusterClient(ctx, meta); return err },
+	}
+	for name, get := range accessors {
+		if err := get(); !errors.Is(err, errFactory) {
+			t.Errorf("%s client: got %v, want the factory to be used", name, err)
+		}
+	}
+}
@@ -1,9 +1,11 @@
 package rafay
 
 import (
+	"fmt"
 	"log"
 	"reflect"
 	"slices"
+	"strings"
 
 	"github.com/RafaySystems/rctl/pkg/cluster"
 	"github.com/RafaySystems/rctl/pkg/models"
@@ -332,6 +334,55 @@ func getProjectIDFromName(projectName string) (string, error) {
 	return project.ID, nil
 }
 
+// importClusterByName is the importer of the legacy cluster resources that
+// keep the cluster and project names in top level attributes and use the
+// cluster ID as resource ID. The import ID is `name/project`.
+func importClusterByName(d *schema.ResourceData, nameKey, projectKey string) ([]*schema.ResourceData, error) {
+	return importCluster(d, func(name, project string) error {
+		if err := d.Set(nameKey, name); err != nil {
+			return err
+		}
+		return d.Set(projectKey, project)
+	})
+}
+
+// importClusterByMetadata is importClusterByName for the cluster resources
+// that keep the names in a metadata block.
+func importClusterByMetadata(d *schema.ResourceData) ([]*schema.ResourceData, error) {
+	return importCluster(d, func(name, project string) error {
+		return d.Set("metadata", []interface{}{
+			map[string]interface{}{
+				"name":    name,
+				"project": project,
+			},
+		})
+	})
+}
+
+func importCluster(d *schema.ResourceData, setNames func(name, project string) error) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("invalid id %s, expected name/project", d.Id())
+	}
+	log.Println("importCluster idParts:", idParts)
+
+	projectID, err := getProjectIDFromName(idParts[1])
+	if err != nil {
+		return nil, err
+	}
+	c, err := cluster.GetCluster(idParts[0], projectID, uaDef)
+	if err != nil {
+		log.Printf("error while getCluster %s", err.Error())
+		return nil, err
+	}
+
+	if err := setNames(idParts[0], idParts[1]); err != nil {
+		return nil, err
+	}
+	d.SetId(c.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func getClusterConditions(edgeId, projectId string) (bool, bool, error) {
 	cluster, err := cluster.GetClusterWithEdgeID(edgeId, projectId, uaDef)
 	if err != nil {
@@ -9,9 +9,7 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -51,13 +49,12 @@ func dataAddonRead(ctx context.Context, d *schema.ResourceData, m interface{}) d
 	// w1 := spew.Sprintf("%+v", meta)
 	// log.Println("dataAddonRead meta", w1)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := addonClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	addon, err := client.InfraV3().Addon().Get(ctx, options.GetOptions{
+	addon, err := client.Get(ctx, options.GetOptions{
 		Name:    meta.Name,
 		Project: meta.Project,
 	})
@@ -9,9 +9,7 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -50,8 +48,7 @@ func dataBluePrintRead(ctx context.Context, d *schema.ResourceData, m interface{
 	// w1 := spew.Sprintf("%+v", tfBlueprintState)
 	// log.Println("dataBluePrintRead tfBlueprintState", w1)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -9,10 +9,7 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -73,13 +70,12 @@ func dataCloudCredentialRead(ctx context.Context, d *schema.ResourceData, m inte
 	name := d.Get("name").(string)
 	project := d.Get("project").(string)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := credentialsClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ag, err := client.InfraV3().Credentials().Get(ctx, options.GetOptions{
+	ag, err := client.Get(ctx, options.GetOptions{
 		Name:    name,
 		Project: project,
 	})
@@ -8,9 +8,6 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -42,13 +39,12 @@ func dataGKEClusterV3Read(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(fmt.Errorf("%s", "failed to read resource "))
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent())
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ag, err := client.InfraV3().Cluster().Get(ctx, options.GetOptions{
+	ag, err := client.Get(ctx, options.GetOptions{
 		Name:    meta.Name,
 		Project: meta.Project,
 	})
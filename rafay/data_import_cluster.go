@@ -283,13 +283,7 @@ func dataImportClusterRead(ctx context.Context, d *schema.ResourceData, m interf
 			break
 		}
 	}
-	if c.Health == 1 {
-		health = "HEALTHY"
-	} else if c.Health == 2 {
-		health = "UNHEALTHY"
-	} else {
-		health = "HEALTH UNKNOWN"
-	}
+	health = clusterHealthString(int(c.Health))
 	if err := d.Set("cluster_health", health); err != nil {
 		log.Printf("set provision_environment  error %s", err.Error())
 		return diag.FromErr(err)
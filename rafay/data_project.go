@@ -9,9 +9,7 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
-	"github.com/RafaySystems/rctl/pkg/config"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -56,13 +54,12 @@ func dataProjectRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	// w1 := spew.Sprintf("%+v", tfProjectState)
 	// log.Println("dataProjectRead tfProjectState", w1)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := projectClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	Project, err := client.SystemV3().Project().Get(ctx, options.GetOptions{
+	Project, err := client.Get(ctx, options.GetOptions{
 		Name: meta.Name,
 		//Project: meta.Project,
 	})
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
 	"github.com/RafaySystems/rctl/pkg/project"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -21,7 +23,7 @@ func dataRafayBlueprints() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema: map[string]*schema.Schema{
+		Schema: listFilterSchema(map[string]*schema.Schema{
 			"projectname": {
 				Description: "Project name from where blueprints to be listed",
 				Type:        schema.TypeString,
@@ -53,10 +55,26 @@ func dataRafayBlueprints() *schema.Resource {
 							Computed:    true,
 							Description: "ownership of the blueprint",
 						},
+						"labels": computedLabelsSchema("blueprint"),
+						"version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current version of the blueprint",
+						},
+						"base_blueprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Base blueprint the blueprint extends",
+						},
+						"base_blueprint_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Version of the base blueprint",
+						},
 					},
 				},
 			},
-		},
+		}, "blueprints", true),
 	}
 }
 
@@ -80,23 +98,60 @@ func dataRafayBlueprintsRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.Errorf("project %s  does not exist, err: %v", d.Get("projectname").(string), err)
 	}
 
-	blueprints := make([]map[string]interface{}, len(bpList))
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	for i, bp := range bpList {
+	// The summary only carries names and counts; labels and versions come
+	// from the blueprint objects themselves.
+	client, err := blueprintClient(m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	bpObjs, err := client.List(ctx, options.ListOptions{Project: project.Name})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	byName := make(map[string]*infrapb.Blueprint, len(bpObjs.Items))
+	for _, bp := range bpObjs.Items {
+		byName[bp.GetMetadata().GetName()] = bp
+	}
+
+	blueprints := make([]map[string]interface{}, 0, len(bpList))
+	for _, bp := range bpList {
+		ownershipType := share.GetOwnershipType(project.ID, bp.ProjectID.String())
+		var labels map[string]string
+		version, baseName, baseVersion := "", "", ""
+		if obj, ok := byName[bp.BlueprintName]; ok {
+			labels = obj.GetMetadata().GetLabels()
+			if obj.Spec != nil {
+				version = obj.Spec.Version
+				if obj.Spec.Base != nil {
+					baseName, baseVersion = obj.Spec.Base.Name, obj.Spec.Base.Version
+				}
+			}
+		}
+		if !filter.matchesName(bp.BlueprintName) || !filter.matchesOwnership(ownershipType) ||
+			!filter.matchesLabels(labels) {
+			continue
+		}
 
 		clusters := 0
 		for _, bss := range bp.Snapshots {
 			clusters += len(bss.Clusters)
 		}
-		ownershipType := share.GetOwnershipType(project.ID, bp.ProjectID.String())
-
-		blueprints[i] = map[string]interface{}{
-			"name":              bp.BlueprintName,
-			"versions":          len(bp.Snapshots),
-			"deployed_clusters": clusters,
-			"ownership":         ownershipType,
-		}
 
+		blueprints = append(blueprints, map[string]interface{}{
+			"name":                   bp.BlueprintName,
+			"versions":               len(bp.Snapshots),
+			"deployed_clusters":      clusters,
+			"ownership":              ownershipType,
+			"labels":                 labelsToState(labels),
+			"version":                version,
+			"base_blueprint":         baseName,
+			"base_blueprint_version": baseVersion,
+		})
 	}
 	if err := d.Set("blueprints", blueprints); err != nil {
 		return diag.FromErr(err)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/RafaySystems/rctl/pkg/cluster"
@@ -12,8 +13,26 @@ import (
 
 	"github.com/RafaySystems/rctl/pkg/share"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// k8sVersionLabel is the system label the console keeps the Kubernetes
+// version of a cluster in.
+const k8sVersionLabel = "rafay.dev/k8sVersion"
+
+// clusterHealthString maps the Health value of a cluster to the name the
+// console shows for it.
+func clusterHealthString(health int) string {
+	switch health {
+	case 1:
+		return "HEALTHY"
+	case 2:
+		return "UNHEALTHY"
+	default:
+		return "HEALTH UNKNOWN"
+	}
+}
+
 func dataRafayClusters() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataRafayClusterRead,
@@ -22,12 +41,33 @@ func dataRafayClusters() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema: map[string]*schema.Schema{
+		Schema: listFilterSchema(map[string]*schema.Schema{
 			"projectname": {
 				Description: "Project name from where clusters to be listed",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"cluster_type": {
+				Description: "Only list clusters of this type, e.g. aks or imported",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"blueprint": {
+				Description: "Only list clusters using this blueprint",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"health": {
+				Description:  "Only list clusters with this health, one of HEALTHY, UNHEALTHY or HEALTH UNKNOWN",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"HEALTHY", "UNHEALTHY", "HEALTH UNKNOWN"}, false),
+			},
+			"include_labels": {
+				Description: "Return the labels and Kubernetes version of the listed clusters. They take a request per cluster, so they are only fetched when this or label_selector is set",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
 			"clusters": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -49,10 +89,48 @@ func dataRafayClusters() *schema.Resource {
 							Computed:    true,
 							Description: "ownership of the cluster.",
 						},
+						"labels": computedLabelsSchema("cluster"),
+						"blueprint": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Blueprint of the cluster.",
+						},
+						"blueprint_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Blueprint version of the cluster.",
+						},
+						"kubernetes_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kubernetes version of the cluster, when reported.",
+						},
+						"health": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health of the cluster, one of HEALTHY, UNHEALTHY or HEALTH UNKNOWN.",
+						},
+						"conditions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Conditions of the cluster.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"status": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
-		},
+		}, "clusters", true),
 	}
 }
 
@@ -74,14 +152,55 @@ func dataRafayClusterRead(ctx context.Context, d *schema.ResourceData, m interfa
 		log.Printf("error in get cluster %s", err.Error())
 		return diag.FromErr(err)
 	}
-	clusters := make([]map[string]interface{}, len(*clusterList))
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	clusterType := d.Get("cluster_type").(string)
+	blueprint := d.Get("blueprint").(string)
+	health := d.Get("health").(string)
+	withLabels := d.Get("include_labels").(bool) || filter.filtersLabels()
 
-	for i, cluster := range *clusterList {
-		clusters[i] = map[string]interface{}{
-			"clustername": cluster.Name,
-			"clustertype": cluster.ClusterType,
-			"ownership":   share.GetOwnershipType(project.ID, cluster.ProjectID),
+	clusters := make([]map[string]interface{}, 0, len(*clusterList))
+	for _, cluster := range *clusterList {
+		ownership := share.GetOwnershipType(project.ID, cluster.ProjectID)
+		clusterHealth := clusterHealthString(int(cluster.Health))
+		if !filter.matchesName(cluster.Name) || !filter.matchesOwnership(ownership) ||
+			(clusterType != "" && !strings.EqualFold(clusterType, cluster.ClusterType)) ||
+			(blueprint != "" && blueprint != cluster.ClusterBlueprint) ||
+			(health != "" && health != clusterHealth) {
+			continue
+		}
+		// Labels take a request per cluster, so they are only fetched when
+		// asked for, and only for clusters that passed the other filters.
+		var labels map[string]string
+		if withLabels {
+			labels, err = getClusterlabels(cluster.Name, project.ID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !filter.matchesLabels(labels) {
+				continue
+			}
+		}
+		conditions := make([]interface{}, 0, len(cluster.Cluster.Conditions))
+		for _, c := range cluster.Cluster.Conditions {
+			conditions = append(conditions, map[string]interface{}{
+				"type":   string(c.Type),
+				"status": string(c.Status),
+			})
 		}
+		clusters = append(clusters, map[string]interface{}{
+			"clustername":        cluster.Name,
+			"clustertype":        cluster.ClusterType,
+			"ownership":          ownership,
+			"labels":             labelsToState(labels),
+			"blueprint":          cluster.ClusterBlueprint,
+			"blueprint_version":  cluster.ClusterBlueprintVersion,
+			"kubernetes_version": labels[k8sVersionLabel],
+			"health":             clusterHealth,
+			"conditions":         conditions,
+		})
 	}
 
 	if err := d.Set("clusters", clusters); err != nil {
@@ -8,12 +8,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rctl/pkg/project"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -25,12 +22,22 @@ func dataRafayEnvironments() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema: map[string]*schema.Schema{
+		Schema: listFilterSchema(map[string]*schema.Schema{
 			"projectname": {
 				Description: "Project name from where environments to be listed",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"template": {
+				Description: "Only list environments created from this environment template",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description: "Only list environments with this status, as reported in their status attribute",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
 			"environments": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -57,10 +64,16 @@ func dataRafayEnvironments() *schema.Resource {
 							Computed:    true,
 							Description: "status of the environment",
 						},
+						"reason": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "reason for the status of the environment",
+						},
+						"labels": computedLabelsSchema("environment"),
 					},
 				},
 			},
-		},
+		}, "environments", false),
 	}
 }
 
@@ -78,13 +91,12 @@ func dataRafayEnvironmentRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.Errorf("project %s  does not exist, err: %v", d.Get("projectname").(string), err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := environmentClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	environments, err := client.EaasV1().Environment().List(ctx, options.ListOptions{
+	environments, err := client.List(ctx, options.ListOptions{
 		Project: project.Name,
 	})
 	if err != nil {
@@ -96,19 +108,34 @@ func dataRafayEnvironmentRead(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	envList := make([]map[string]interface{}, len(environments.Items))
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	template := d.Get("template").(string)
+	status := d.Get("status").(string)
 
-	for i, e := range environments.Items {
+	envList := make([]map[string]interface{}, 0, len(environments.Items))
+	for _, e := range environments.Items {
 		envStatus := "unkown"
+		reason := ""
 		if (e.Status != nil) && (e.Status.DigestedStatus != nil) {
 			envStatus = e.Status.DigestedStatus.ConditionStatus.Enum().String()
+			reason = e.Status.DigestedStatus.GetReason()
+		}
+		if !filter.matchesName(e.Metadata.Name) || !filter.matchesLabels(e.Metadata.Labels) ||
+			(template != "" && template != e.Spec.Template.Name) ||
+			(status != "" && !strings.EqualFold(status, envStatus)) {
+			continue
 		}
-		envList[i] = map[string]interface{}{
+		envList = append(envList, map[string]interface{}{
 			"environment_name":          e.Metadata.Name,
 			"environment_template_name": e.Spec.Template.Name,
 			"template_version":          e.Spec.Template.Version,
 			"status":                    envStatus,
-		}
+			"reason":                    reason,
+			"labels":                    labelsToState(e.Metadata.Labels),
+		})
 	}
 	if err := d.Set("environments", envList); err != nil {
 		return diag.FromErr(err)
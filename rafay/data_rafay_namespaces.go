@@ -8,13 +8,11 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rctl/pkg/project"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataRafayNamespaces() *schema.Resource {
@@ -25,12 +23,18 @@ func dataRafayNamespaces() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema: map[string]*schema.Schema{
+		Schema: listFilterSchema(map[string]*schema.Schema{
 			"projectname": {
 				Description: "Project name from where namespaces to be listed",
 				Type:        schema.TypeString,
 				Required:    true,
 			},
+			"type": {
+				Description:  "Only list namespaces of this type, one of Wizard, Repo or Uploaded",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Wizard", "Repo", "Uploaded"}, false),
+			},
 			"namespaces": {
 				Type:        schema.TypeList,
 				Computed:    true,
@@ -52,10 +56,11 @@ func dataRafayNamespaces() *schema.Resource {
 							Computed:    true,
 							Description: "status of the namespace",
 						},
+						"labels": computedLabelsSchema("namespace"),
 					},
 				},
 			},
-		},
+		}, "namespaces", false),
 	}
 }
 
@@ -73,13 +78,12 @@ func dataRafayNamespaceRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.Errorf("project %s  does not exist, err: %v", d.Get("projectname").(string), err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := namespaceClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ns, err := client.InfraV3().Namespace().List(ctx, options.ListOptions{
+	ns, err := client.List(ctx, options.ListOptions{
 		//Name:    nsTFState.Metadata.Name,
 		Project: project.Name,
 	})
@@ -92,9 +96,17 @@ func dataRafayNamespaceRead(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	namespaces := make([]map[string]interface{}, len(ns.Items))
+	filter, err := expandListFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	nsType := d.Get("type").(string)
 
-	for i, n := range ns.Items {
+	namespaces := make([]map[string]interface{}, 0, len(ns.Items))
+	for _, n := range ns.Items {
+		if !filter.matchesName(n.Metadata.Name) || !filter.matchesLabels(n.Metadata.Labels) {
+			continue
+		}
 		tempType := ""
 		var managedClusterList strings.Builder
 		if n.Spec.Artifact != nil {
@@ -107,18 +119,21 @@ func dataRafayNamespaceRead(ctx context.Context, d *schema.ResourceData, m inter
 		} else {
 			tempType = "Wizard"
 		}
+		if nsType != "" && nsType != tempType {
+			continue
+		}
 		for i, ClusterName := range n.Status.DeployedClusters {
 			managedClusterList.WriteString(ClusterName)
 			if i != len(n.Status.DeployedClusters)-1 {
 				managedClusterList.WriteString(", ")
 			}
 		}
-		namespaces[i] = map[string]interface{}{
+		namespaces = append(namespaces, map[string]interface{}{
 			"name":              n.Metadata.Name,
 			"type":              tempType,
 			"deployed_clusters": managedClusterList.String(),
-		}
-
+			"labels":            labelsToState(n.Metadata.Labels),
+		})
 	}
 	if err := d.Set("namespaces", namespaces); err != nil {
 		return diag.FromErr(err)
@@ -7,14 +7,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/user"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -77,31 +72,17 @@ func dataWorkloadRead(ctx context.Context, d *schema.ResourceData, m interface{}
 	// w1 := spew.Sprintf("%+v", tfWorkloadState)
 	// log.Println("dataWorkloadRead tfWorkloadState", w1)
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err := withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := workloadClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	wl, err := client.AppsV3().Workload().Get(ctx, options.GetOptions{
+	wl, err := client.Get(ctx, options.GetOptions{
 		Name:    meta.Name,
 		Project: meta.Project,
 	})
@@ -130,7 +111,7 @@ func dataWorkloadRead(ctx context.Context, d *schema.ResourceData, m interface{}
 		return diag.FromErr(err)
 	}
 
-	wls, err := client.AppsV3().Workload().Status(ctx, options.StatusOptions{
+	wls, err := client.Status(ctx, options.StatusOptions{
 		Name:    wl.Metadata.Name,
 		Project: wl.Metadata.Project,
 	})
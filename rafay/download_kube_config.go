@@ -94,50 +94,29 @@ func getUserDetails(username string) (accountId string, err error) {
 	return accountId, nil
 }
 
-func downloadKubeConfigUtil(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var diags diag.Diagnostics
+// GetKubeConfig downloads the kubeconfig for the calling user (optionally
+// scoped to a cluster and default namespace) or, when username is set, for
+// that user's account. The decoded kubeconfig YAML is returned as-is.
+func GetKubeConfig(cluster, defaultNamespace, username string) (string, error) {
 	var err error
-	log.Printf("download kube config starts")
-	tflog := os.Getenv("TF_LOG")
-	if tflog == "TRACE" || tflog == "DEBUG" {
-		ctx = context.WithValue(ctx, "debug", "true")
-	}
 	auth := config.GetConfig().GetAppAuthProfile()
 
-	defaultNamespace := d.Get("namespace").(string)
-	cluster := d.Get("cluster").(string)
-	filepath := ""
-	if d.Get("output_folder_path").(string) != "" {
-		filepath = d.Get("output_folder_path").(string)
-	}
-
-	filename := "kubeconfig-file"
-	if d.Get("filename").(string) != "" {
-		filename = d.Get("filename").(string)
-
-	}
-
-	username := ""
 	accountID := ""
-	if d.Get("username").(string) != "" {
-		username = d.Get("username").(string)
+	if username != "" {
 		accountID, err = getUserDetails(username)
 		if err != nil {
 			log.Printf("failed to get kubeconfig for user %s; err: %s", username, err)
-			return diag.FromErr(fmt.Errorf("failed to get kubeconfig for user: %s", username))
+			return "", fmt.Errorf("failed to get kubeconfig for user: %s", username)
 		}
 	}
 
 	if accountID != "" && (defaultNamespace != "" || cluster != "") {
 		if cluster != "" {
 			log.Printf("cluster '%s' argument must not be provided when username %s is given in resource configuration", cluster, username)
-			return diag.FromErr(fmt.Errorf("cluster '%s' argument must not be provided when username %s is given in resource configuration", cluster, username))
-		}
-		if defaultNamespace != "" {
-			log.Printf("namespace '%s' argument must not be provided when username %s is given in resource configuration", defaultNamespace, username)
-			return diag.FromErr(fmt.Errorf("namespace '%s' argument must not be provided when username %s is given in resource configuration", defaultNamespace, username))
+			return "", fmt.Errorf("cluster '%s' argument must not be provided when username %s is given in resource configuration", cluster, username)
 		}
-		return diags
+		log.Printf("namespace '%s' argument must not be provided when username %s is given in resource configuration", defaultNamespace, username)
+		return "", fmt.Errorf("namespace '%s' argument must not be provided when username %s is given in resource configuration", defaultNamespace, username)
 	}
 
 	params := url.Values{}
@@ -158,7 +137,7 @@ func downloadKubeConfigUtil(ctx context.Context, d *schema.ResourceData, m inter
 	resp, err := auth.AuthAndRequestFullResponse(uri, "GET", nil)
 	if err != nil {
 		log.Println("failed to get kubeconfig; err:", err)
-		return diag.FromErr(fmt.Errorf("failed to get kubeconfig; err: %s", err))
+		return "", fmt.Errorf("failed to get kubeconfig; err: %s", err)
 	}
 
 	jsonData := &struct {
@@ -168,15 +147,42 @@ func downloadKubeConfigUtil(ctx context.Context, d *schema.ResourceData, m inter
 	err = resp.JSON(jsonData)
 	if err != nil {
 		log.Println("failed to unmarshal kubeconfig jsonData error", err)
-		return diag.FromErr(fmt.Errorf("failed to unmarshal kubeconfig jsonData; err: %s", err))
+		return "", fmt.Errorf("failed to unmarshal kubeconfig jsonData; err: %s", err)
 	}
 
 	decoded, err := base64.StdEncoding.DecodeString(jsonData.Data)
 	if err != nil {
 		log.Println("failed to decode kubeconfig error", err)
-		return diag.FromErr(fmt.Errorf("failed to decode kubeconfig; err: %s", err))
+		return "", fmt.Errorf("failed to decode kubeconfig; err: %s", err)
+	}
+	return string(decoded), nil
+}
+
+func downloadKubeConfigUtil(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	log.Printf("download kube config starts")
+	tflog := os.Getenv("TF_LOG")
+	if tflog == "TRACE" || tflog == "DEBUG" {
+		ctx = context.WithValue(ctx, "debug", "true")
+	}
+
+	defaultNamespace := d.Get("namespace").(string)
+	cluster := d.Get("cluster").(string)
+	filepath := ""
+	if d.Get("output_folder_path").(string) != "" {
+		filepath = d.Get("output_folder_path").(string)
+	}
+
+	filename := "kubeconfig-file"
+	if d.Get("filename").(string) != "" {
+		filename = d.Get("filename").(string)
+
+	}
+
+	yaml, err := GetKubeConfig(cluster, defaultNamespace, d.Get("username").(string))
+	if err != nil {
+		return diag.FromErr(err)
 	}
-	yaml := string(decoded)
 
 	fileLocation := filepath + "/" + filename
 	err = os.WriteFile(fileLocation, []byte(yaml), 0644)
@@ -0,0 +1,81 @@
+package rafay
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
+	"github.com/RafaySystems/rctl/pkg/config"
+	"github.com/RafaySystems/rctl/pkg/user"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// impersonationKey is the context key under which withImpersonation stores
+// the client authenticated as the impersonated user.
+type impersonationKey struct{}
+
+// impersonation is the per-call credential scope of an impersonated user.
+type impersonation struct {
+	user   string
+	client typed.Client
+}
+
+// withImpersonation returns ctx unchanged unless the resource sets
+// `impersonate`. In that case the API key of that user is looked up and a
+// dedicated hub client for it is carried on the returned context. The global
+// rctl credentials are never touched, so impersonated and regular resources
+// can safely run in parallel.
+func withImpersonation(ctx context.Context, d *schema.ResourceData, m interface{}) (context.Context, error) {
+	asUser, ok := d.Get("impersonate").(string)
+	if !ok || asUser == "" {
+		return ctx, nil
+	}
+
+	cfg := config.GetConfig()
+	if meta, ok := m.(*ProviderMeta); ok && meta.Config() != nil {
+		cfg = meta.Config()
+	}
+	client, err := impersonatedClient(cfg, asUser)
+	if err != nil {
+		return ctx, err
+	}
+	log.Println("impersonating user", asUser)
+
+	return context.WithValue(ctx, impersonationKey{}, &impersonation{
+		user:   asUser,
+		client: client,
+	}), nil
+}
+
+// ImpersonatedClient returns a hub client authenticated as asUser, for the
+// framework resources supporting `impersonate`.
+func ImpersonatedClient(asUser string) (typed.Client, error) {
+	return impersonatedClient(config.GetConfig(), asUser)
+}
+
+func impersonatedClient(cfg *config.Config, asUser string) (typed.Client, error) {
+	// check user role : impersonation not allowed for a user
+	// with ORG Admin role
+	isOrgAdmin, err := user.IsOrgAdmin(asUser)
+	if err != nil {
+		return nil, err
+	}
+	if isOrgAdmin {
+		return nil, fmt.Errorf("%s", "--as-user cannot have ORGADMIN role")
+	}
+	apiKey, _, err := user.GetUserAPIKey(asUser)
+	if err != nil {
+		return nil, err
+	}
+	return newHubClientWithKey(cfg, apiKey)
+}
+
+// hubClientFromContext returns the impersonated client carried by ctx, or
+// the shared provider client when the call is not impersonated.
+func hubClientFromContext(ctx context.Context, m interface{}) (typed.Client, error) {
+	if imp, ok := ctx.Value(impersonationKey{}).(*impersonation); ok {
+		return imp.client, nil
+	}
+	return getHubClient(m)
+}
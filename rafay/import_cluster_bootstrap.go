@@ -0,0 +1,387 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/RafaySystems/rctl/pkg/cluster"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sschema "k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// bootstrapFieldManager owns the fields the provider sets when applying the
+// import cluster bootstrap server-side.
+const bootstrapFieldManager = "terraform-provider-rafay"
+
+// clusterHealthy is the Health value the console reports for a cluster
+// whose operator is connected and healthy.
+const clusterHealthy = 1
+
+// defaultBootstrapTimeout is the default of bootstrap_timeout, the wait for
+// the Rafay operator to become available and the console to report the
+// cluster healthy once the bootstrap is applied.
+const defaultBootstrapTimeout = 10 * time.Minute
+
+// errBootstrapTimeout is wrapped by the error returned when the imported
+// cluster did not connect within bootstrap_timeout.
+var errBootstrapTimeout = errors.New("timed out waiting for the imported cluster to connect")
+
+var deploymentGVR = k8sschema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+// importClusterBootstrapTimeoutSchema is the bootstrap_timeout attribute of
+// rafay_import_cluster.
+func importClusterBootstrapTimeoutSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateDiagFunc: validateBootstrapTimeout,
+		Description:      "How long to wait, once the bootstrap is applied, for the Rafay operator to become available and the console to report the cluster healthy, e.g. 15m. Defaults to 10m. Only used when kubernetes or kubeconfig_path is set. The wait also ends with the create timeout",
+	}
+}
+
+// importClusterBootstrapTimeout returns the bootstrap_timeout of the
+// resource, or its default when unset.
+func importClusterBootstrapTimeout(d *schema.ResourceData) time.Duration {
+	if t, err := time.ParseDuration(d.Get("bootstrap_timeout").(string)); err == nil && t > 0 {
+		return t
+	}
+	return defaultBootstrapTimeout
+}
+
+func validateBootstrapTimeout(v interface{}, _ cty.Path) diag.Diagnostics {
+	d, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return diag.Errorf("invalid bootstrap_timeout %q: %s", v, err)
+	}
+	if d <= 0 {
+		return diag.Errorf("bootstrap_timeout %q must be a positive duration", v)
+	}
+	return nil
+}
+
+func importClusterKubernetesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: []string{"kubeconfig_path"},
+		Description:   "Connection to the cluster being imported. When set, or when kubeconfig_path is set, the provider applies the bootstrap itself, waits for the Rafay operator to come up and removes it on destroy",
+		Elem: &schema.Resource{Schema: map[string]*schema.Schema{
+			"kubeconfig": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Contents of a kubeconfig file. Its current context is used",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Address of the Kubernetes API server, used when kubeconfig is not set",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Bearer token authenticating to the API server",
+			},
+			"cluster_ca_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "PEM encoded CA certificate of the API server",
+			},
+			"insecure": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Skip verification of the API server certificate",
+			},
+		}},
+	}
+}
+
+// importClusterRESTConfig returns the config for reaching the imported
+// cluster, or nil when the resource configures no connection.
+func importClusterRESTConfig(d *schema.ResourceData) (*rest.Config, error) {
+	if v, ok := d.Get("kubernetes").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		in := v[0].(map[string]interface{})
+		if kc, _ := in["kubeconfig"].(string); kc != "" {
+			cfg, err := clientcmd.RESTConfigFromKubeConfig([]byte(kc))
+			if err != nil {
+				return nil, fmt.Errorf("invalid kubernetes.kubeconfig: %w", err)
+			}
+			return cfg, nil
+		}
+		host, _ := in["host"].(string)
+		if host == "" {
+			return nil, fmt.Errorf("the kubernetes block needs either kubeconfig or host")
+		}
+		token, _ := in["token"].(string)
+		ca, _ := in["cluster_ca_certificate"].(string)
+		insecure, _ := in["insecure"].(bool)
+		return &rest.Config{
+			Host:        host,
+			BearerToken: token,
+			TLSClientConfig: rest.TLSClientConfig{
+				CAData:   []byte(ca),
+				Insecure: insecure,
+			},
+		}, nil
+	}
+	if path := d.Get("kubeconfig_path").(string); path != "" {
+		cfg, err := clientcmd.BuildConfigFromFlags("", path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load kubeconfig %s: %w", path, err)
+		}
+		return cfg, nil
+	}
+	return nil, nil
+}
+
+// bootstrapClient applies and deletes the objects of a bootstrap manifest.
+type bootstrapClient struct {
+	dynamic dynamic.Interface
+	mapper  *restmapper.DeferredDiscoveryRESTMapper
+}
+
+func newBootstrapClient(cfg *rest.Config) (*bootstrapClient, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dyn, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &bootstrapClient{
+		dynamic: dyn,
+		mapper:  restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc)),
+	}, nil
+}
+
+// decodeManifest splits a multi-document YAML manifest into objects,
+// skipping empty documents.
+func decodeManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	dec := k8syaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	var objs []*unstructured.Unstructured
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := dec.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, fmt.Errorf("unable to decode bootstrap manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+}
+
+func (c *bootstrapClient) resourceFor(obj *unstructured.Unstructured) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		ns := obj.GetNamespace()
+		if ns == "" {
+			ns = metav1.NamespaceDefault
+		}
+		return c.dynamic.Resource(mapping.Resource).Namespace(ns), nil
+	}
+	return c.dynamic.Resource(mapping.Resource), nil
+}
+
+// apply server-side applies objs in manifest order. Kinds defined by a CRD
+// earlier in the manifest are only served once the CRD is established, so
+// an unknown kind is rediscovered until it appears.
+func (c *bootstrapClient) apply(ctx context.Context, objs []*unstructured.Unstructured) error {
+	force := true
+	for _, obj := range objs {
+		obj := obj
+		desc := fmt.Sprintf("%s %s", obj.GetKind(), obj.GetName())
+		err := pollUntil(ctx, pollSpec{
+			Operation:   "apply of bootstrap " + desc,
+			Timeout:     2 * time.Minute,
+			MinInterval: 2 * time.Second,
+			MaxInterval: 10 * time.Second,
+		}, func(ctx context.Context) (bool, string, error) {
+			ri, err := c.resourceFor(obj)
+			if meta.IsNoMatchError(err) {
+				c.mapper.Reset()
+				return false, "waiting for kind " + obj.GetKind(), nil
+			}
+			if err != nil {
+				return false, "", err
+			}
+			data, err := obj.MarshalJSON()
+			if err != nil {
+				return false, "", err
+			}
+			_, err = ri.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+				FieldManager: bootstrapFieldManager,
+				Force:        &force,
+			})
+			if err != nil {
+				return false, "", fmt.Errorf("unable to apply %s: %w", desc, err)
+			}
+			return true, "applied", nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// waitForDeployments waits until every Deployment in objs has all its
+// replicas available, i.e. the operator pods are running and ready.
+func (c *bootstrapClient) waitForDeployments(ctx context.Context, objs []*unstructured.Unstructured) error {
+	for _, obj := range objs {
+		if obj.GroupVersionKind().GroupKind() != (k8sschema.GroupKind{Group: "apps", Kind: "Deployment"}) {
+			continue
+		}
+		ns, name := obj.GetNamespace(), obj.GetName()
+		if ns == "" {
+			ns = metav1.NamespaceDefault
+		}
+		err := pollUntil(ctx, pollSpec{
+			Operation:   fmt.Sprintf("rollout of deployment %s/%s", ns, name),
+			MinInterval: 5 * time.Second,
+			MaxInterval: 20 * time.Second,
+		}, func(ctx context.Context) (bool, string, error) {
+			dep, err := c.dynamic.Resource(deploymentGVR).Namespace(ns).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return false, "", err
+			}
+			replicas, found, _ := unstructured.NestedInt64(dep.Object, "spec", "replicas")
+			if !found {
+				replicas = 1
+			}
+			available, _, _ := unstructured.NestedInt64(dep.Object, "status", "availableReplicas")
+			observed, _, _ := unstructured.NestedInt64(dep.Object, "status", "observedGeneration")
+			status := fmt.Sprintf("%d/%d replicas available", available, replicas)
+			return observed >= dep.GetGeneration() && available >= replicas, status, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// delete removes objs in reverse manifest order, so namespaced objects go
+// before their namespace and custom resources before their CRD. Objects
+// already gone are skipped.
+func (c *bootstrapClient) delete(ctx context.Context, objs []*unstructured.Unstructured) error {
+	propagation := metav1.DeletePropagationBackground
+	var errs []error
+	for i := len(objs) - 1; i >= 0; i-- {
+		obj := objs[i]
+		ri, err := c.resourceFor(obj)
+		if meta.IsNoMatchError(err) {
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		err = ri.Delete(ctx, obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+		if err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("unable to delete %s %s: %w", obj.GetKind(), obj.GetName(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// waitForOperator waits, for at most timeout, until the Deployments in objs
+// are available and healthy reports the cluster connected. Running out of
+// time fails with an error wrapping errBootstrapTimeout.
+func (c *bootstrapClient) waitForOperator(ctx context.Context, objs []*unstructured.Unstructured, clusterName string, timeout time.Duration, healthy pollFunc) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := c.waitForDeployments(ctx, objs)
+	if err == nil {
+		err = pollUntil(ctx, pollSpec{
+			Operation:   "cluster " + clusterName + " to report healthy",
+			MinInterval: 10 * time.Second,
+			MaxInterval: 30 * time.Second,
+		}, healthy)
+	}
+	if errors.Is(err, errPollTimeout) {
+		return fmt.Errorf("%w within bootstrap_timeout %s: %v", errBootstrapTimeout, timeout, err)
+	}
+	return err
+}
+
+// clusterHealthPoll reports whether the console sees the cluster healthy.
+func clusterHealthPoll(clusterName, projectID string) pollFunc {
+	return func(ctx context.Context) (bool, string, error) {
+		c, err := cluster.GetCluster(clusterName, projectID, "")
+		if err != nil {
+			return false, "", err
+		}
+		c, err = cluster.GetClusterWithEdgeID(c.ID, c.ProjectID, "")
+		if err != nil {
+			return false, "", err
+		}
+		if c.Health == clusterHealthy {
+			return true, "healthy", nil
+		}
+		return false, fmt.Sprintf("health %d", c.Health), nil
+	}
+}
+
+// applyImportClusterBootstrap applies the bootstrap to the cluster being
+// imported and waits, for at most timeout, until the Rafay operator is ready
+// and the console reports the cluster healthy.
+func applyImportClusterBootstrap(ctx context.Context, cfg *rest.Config, manifest, clusterName, projectID string, timeout time.Duration) error {
+	objs, err := decodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+	client, err := newBootstrapClient(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to connect to the cluster: %w", err)
+	}
+	if err := client.apply(ctx, objs); err != nil {
+		return err
+	}
+	log.Printf("bootstrap applied to cluster %s, waiting up to %s for the operator", clusterName, timeout)
+	return client.waitForOperator(ctx, objs, clusterName, timeout, clusterHealthPoll(clusterName, projectID))
+}
+
+// deleteImportClusterBootstrap removes the objects of the bootstrap, and
+// with them the Rafay operator, from the imported cluster.
+func deleteImportClusterBootstrap(ctx context.Context, cfg *rest.Config, manifest string) error {
+	objs, err := decodeManifest(manifest)
+	if err != nil {
+		return err
+	}
+	client, err := newBootstrapClient(cfg)
+	if err != nil {
+		return fmt.Errorf("unable to connect to the cluster: %w", err)
+	}
+	return client.delete(ctx, objs)
+}
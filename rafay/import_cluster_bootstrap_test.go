@@ -0,0 +1,69 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func TestDecodeManifest(t *testing.T) {
+	manifest := `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: rafay-system
+---
+# only a comment
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: controller-manager
+  namespace: rafay-system
+spec:
+  replicas: 1
+`
+	objs, err := decodeManifest(manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objs) != 2 {
+		t.Fatalf("decoded %d objects, want 2", len(objs))
+	}
+	if objs[0].GetKind() != "Namespace" || objs[1].GetName() != "controller-manager" || objs[1].GetNamespace() != "rafay-system" {
+		t.Errorf("unexpected objects %v", objs)
+	}
+
+	if _, err := decodeManifest("kind: [unterminated"); err == nil {
+		t.Error("expected an error for invalid YAML")
+	}
+}
+
+func TestWaitForOperatorTimeout(t *testing.T) {
+	dep := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "controller-manager", "namespace": "rafay-system"},
+		"spec":       map[string]interface{}{"replicas": int64(1)},
+	}}
+	c := &bootstrapClient{dynamic: fake.NewSimpleDynamicClient(runtime.NewScheme(), dep.DeepCopy())}
+	healthy := func(context.Context) (bool, string, error) { return true, "healthy", nil }
+
+	// The deployment never becomes available.
+	err := c.waitForOperator(context.Background(), []*unstructured.Unstructured{dep}, "c1", 50*time.Millisecond, healthy)
+	if !errors.Is(err, errBootstrapTimeout) {
+		t.Fatalf("got %v, want a bootstrap timeout", err)
+	}
+
+	// Errors other than running out of time are returned as they are.
+	failing := func(context.Context) (bool, string, error) { return false, "", errors.New("forbidden") }
+	err = c.waitForOperator(context.Background(), nil, "c1", time.Minute, failing)
+	if err == nil || errors.Is(err, errBootstrapTimeout) {
+		t.Fatalf("got %v, want the poll error", err)
+	}
+}
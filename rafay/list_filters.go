@@ -0,0 +1,100 @@
+package rafay
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// listFilter holds the filters shared by the list data sources such as
+// rafay_clusters and rafay_namespaces. Filters left unset match everything.
+type listFilter struct {
+	nameRegex *regexp.Regexp
+	selector  labels.Selector
+	ownership string
+}
+
+// listFilterSchema adds the name_regex, label_selector and, when
+// withOwnership is set, ownership filter attributes to s. kind names the
+// listed objects in the descriptions.
+func listFilterSchema(s map[string]*schema.Schema, kind string, withOwnership bool) map[string]*schema.Schema {
+	s["name_regex"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Description:  fmt.Sprintf("Only list %s whose name matches this regular expression", kind),
+		ValidateFunc: validation.StringIsValidRegExp,
+	}
+	s["label_selector"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: fmt.Sprintf("Only list %s whose labels match this Kubernetes style label selector, e.g. env=prod,tier in (web,api)", kind),
+	}
+	if withOwnership {
+		s["ownership"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: fmt.Sprintf("Only list %s with this ownership, as reported in their ownership attribute", kind),
+		}
+	}
+	return s
+}
+
+func expandListFilter(d *schema.ResourceData) (*listFilter, error) {
+	f := &listFilter{selector: labels.Everything()}
+	if v, ok := d.Get("name_regex").(string); ok && v != "" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name_regex: %w", err)
+		}
+		f.nameRegex = re
+	}
+	if v, ok := d.Get("label_selector").(string); ok && v != "" {
+		sel, err := labels.Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label_selector: %w", err)
+		}
+		f.selector = sel
+	}
+	if v, ok := d.GetOk("ownership"); ok {
+		f.ownership = v.(string)
+	}
+	return f, nil
+}
+
+func (f *listFilter) matchesName(name string) bool {
+	return f.nameRegex == nil || f.nameRegex.MatchString(name)
+}
+
+func (f *listFilter) matchesOwnership(ownership string) bool {
+	return f.ownership == "" || f.ownership == ownership
+}
+
+// filtersLabels reports whether a label_selector was set.
+func (f *listFilter) filtersLabels() bool {
+	return !f.selector.Empty()
+}
+
+func (f *listFilter) matchesLabels(l map[string]string) bool {
+	return f.selector.Matches(labels.Set(l))
+}
+
+// labelsToState converts labels to the form expected by a TypeMap attribute.
+func labelsToState(l map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(l))
+	for k, v := range l {
+		out[k] = v
+	}
+	return out
+}
+
+func computedLabelsSchema(kind string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeMap,
+		Computed:    true,
+		Description: fmt.Sprintf("Labels of the %s", kind),
+		Elem:        &schema.Schema{Type: schema.TypeString},
+	}
+}
@@ -0,0 +1,62 @@
+package rafay
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestListFilter(t *testing.T) {
+	r := &schema.Resource{Schema: listFilterSchema(map[string]*schema.Schema{}, "clusters", true)}
+	d := r.TestResourceData()
+	for k, v := range map[string]string{
+		"name_regex":     "^prod-",
+		"label_selector": "env=prod,tier in (web,api)",
+		"ownership":      "self",
+	} {
+		if err := d.Set(k, v); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	f, err := expandListFilter(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.matchesName("prod-east") || f.matchesName("dev-east") {
+		t.Error("name_regex not applied")
+	}
+	if !f.matchesOwnership("self") || f.matchesOwnership("shared") {
+		t.Error("ownership not applied")
+	}
+	if !f.matchesLabels(map[string]string{"env": "prod", "tier": "web"}) {
+		t.Error("matching labels rejected")
+	}
+	if f.matchesLabels(map[string]string{"env": "prod", "tier": "db"}) || f.matchesLabels(nil) {
+		t.Error("non-matching labels accepted")
+	}
+	if !f.filtersLabels() {
+		t.Error("label_selector not reported as set")
+	}
+
+	if err := d.Set("label_selector", "env in prod"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := expandListFilter(d); err == nil {
+		t.Error("expected an error for an invalid label_selector")
+	}
+}
+
+func TestListFilterUnset(t *testing.T) {
+	r := &schema.Resource{Schema: listFilterSchema(map[string]*schema.Schema{}, "namespaces", false)}
+	f, err := expandListFilter(r.TestResourceData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !f.matchesName("anything") || !f.matchesOwnership("shared") || !f.matchesLabels(nil) {
+		t.Error("unset filters should match everything")
+	}
+	if f.filtersLabels() {
+		t.Error("unset label_selector reported as set")
+	}
+}
@@ -0,0 +1,112 @@
+package toV3
+
+import (
+	"fmt"
+)
+
+// SourceType is the resource the state of rafay_aks_cluster_v3 can be moved
+// from with a moved block.
+const SourceType = "rafay_aks_cluster"
+
+func resolve(rawState map[string]interface{}, path, field string) (map[string]interface{}, error) {
+	attr, ok := rawState[field]
+	if !ok {
+		return nil, fmt.Errorf("field %s at path %s: not found", field, path)
+	}
+	attrArray, ok := attr.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("field %s at path %s: not an array", field, path)
+	}
+	if len(attrArray) != 1 {
+		return nil, fmt.Errorf("field %s at path %s: invalid array of length %d", field, path, len(attrArray))
+	}
+	attrMap, ok := attrArray[0].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("first attr of field %s at path %s: not a map", field, path)
+	}
+	return attrMap, nil
+}
+
+// rename renames the field from to to in each element of the block list
+// field of m, if present.
+func rename(m map[string]interface{}, field, from, to string) {
+	blocks, ok := m[field].([]interface{})
+	if !ok {
+		return
+	}
+	for _, b := range blocks {
+		if bm, ok := b.(map[string]interface{}); ok {
+			if v, ok := bm[from]; ok {
+				bm[to] = v
+				delete(bm, from)
+			}
+		}
+	}
+}
+
+// Move translates the state of a rafay_aks_cluster, at its current schema
+// version, into the layout of rafay_aks_cluster_v3:
+//
+//	spec.0.blueprint, spec.0.blueprintversion -> spec.0.blueprint_config
+//	spec.0.cloudprovider                      -> spec.0.cloud_credentials
+//	spec.0.cluster_config                     -> spec.0.config
+//	*.apiversion                              -> *.api_version
+//	*.daemonset_override                      -> *.daemon_set_override
+//
+// The rest of the AKS cluster config shares its layout between the two.
+// Attributes rafay_aks_cluster_v3 does not have are left for the caller to
+// drop against its schema.
+func Move(rawState map[string]interface{}) (map[string]interface{}, error) {
+	metadata, err := resolve(rawState, "", "metadata")
+	if err != nil {
+		return nil, err
+	}
+	spec, err := resolve(rawState, "", "spec")
+	if err != nil {
+		return nil, err
+	}
+	clusterConfig, err := resolve(spec, "spec", "cluster_config")
+	if err != nil {
+		return nil, err
+	}
+	clusterConfigSpec, err := resolve(clusterConfig, "spec.cluster_config", "spec")
+	if err != nil {
+		return nil, err
+	}
+	name, ok := metadata["name"].(string)
+	if !ok || name == "" {
+		return nil, fmt.Errorf("field name at path metadata: not found")
+	}
+
+	rename(clusterConfigSpec, "managed_cluster", "apiversion", "api_version")
+	rename(clusterConfigSpec, "node_pools", "apiversion", "api_version")
+	rename(spec, "system_components_placement", "daemonset_override", "daemon_set_override")
+
+	blueprint := map[string]interface{}{"name": spec["blueprint"]}
+	if v, ok := spec["blueprintversion"].(string); ok && v != "" {
+		blueprint["version"] = v
+	}
+
+	return map[string]interface{}{
+		// rafay_aks_cluster_v3 is identified by the cluster name
+		"id":          name,
+		"api_version": "infra.k8smgmt.io/v3",
+		"kind":        "Cluster",
+		"metadata":    rawState["metadata"],
+		"spec": []interface{}{map[string]interface{}{
+			"type":                        spec["type"],
+			"blueprint_config":            []interface{}{blueprint},
+			"cloud_credentials":           spec["cloudprovider"],
+			"sharing":                     spec["sharing"],
+			"proxy_config":                spec["proxy_config"],
+			"system_components_placement": spec["system_components_placement"],
+			"config": []interface{}{map[string]interface{}{
+				"api_version": clusterConfig["apiversion"],
+				"kind":        clusterConfig["kind"],
+				"metadata":    clusterConfig["metadata"],
+				"spec":        clusterConfig["spec"],
+			}},
+		}},
+		"timeouts": rawState["timeouts"],
+	}, nil
+}
@@ -0,0 +1,217 @@
+package toV3_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/rafay/migrate/aks/toV3"
+)
+
+var v1StateJsonStr string = `
+{
+	"apiversion": "rafay.io/v1alpha1",
+	"id": "rx28oml",
+	"kind": "Cluster",
+	"metadata": [
+	  {
+		"labels": {},
+		"name": "thiru-tf-2",
+		"project": "defaultproject"
+	  }
+	],
+	"spec": [
+	  {
+		"blueprint": "minimal",
+		"blueprintversion": "",
+		"cloudprovider": "gautham-azure-creds",
+		"cluster_config": [
+		  {
+			"apiversion": "rafay.io/v1alpha1",
+			"kind": "aksClusterConfig",
+			"metadata": [
+			  {
+				"name": "thiru-tf-2"
+			  }
+			],
+			"spec": [
+			  {
+				"managed_cluster": [
+				  {
+					"apiversion": "2022-07-01",
+					"location": "centralindia",
+					"properties": [
+					  {
+						"dns_prefix": "testuser-test-dns",
+						"kubernetes_version": "1.28.5"
+					  }
+					],
+					"type": "Microsoft.ContainerService/managedClusters"
+				  }
+				],
+				"node_pools": [
+				  {
+					"apiversion": "2022-07-01",
+					"location": "centralindia",
+					"name": "pool1",
+					"properties": [
+					  {
+						"count": 1,
+						"mode": "System",
+						"vm_size": "Standard_B2s"
+					  }
+					],
+					"type": "Microsoft.ContainerService/managedClusters/agentPools"
+				  }
+				],
+				"resource_group_name": "gautham-rg-ci",
+				"subscription_id": ""
+			  }
+			]
+		  }
+		],
+		"proxy_config": [],
+		"sharing": [],
+		"system_components_placement": [
+		  {
+			"daemonset_override": [
+			  {
+				"node_selection_enabled": true,
+				"tolerations": []
+			  }
+			],
+			"node_selector": {
+			  "role": "system"
+			},
+			"tolerations": []
+		  }
+		],
+		"type": "aks"
+	  }
+	],
+	"timeouts": null
+}
+`
+
+var v3StateJsonStr string = `
+{
+	"api_version": "infra.k8smgmt.io/v3",
+	"id": "thiru-tf-2",
+	"kind": "Cluster",
+	"metadata": [
+	  {
+		"labels": {},
+		"name": "thiru-tf-2",
+		"project": "defaultproject"
+	  }
+	],
+	"spec": [
+	  {
+		"blueprint_config": [
+		  {
+			"name": "minimal"
+		  }
+		],
+		"cloud_credentials": "gautham-azure-creds",
+		"config": [
+		  {
+			"api_version": "rafay.io/v1alpha1",
+			"kind": "aksClusterConfig",
+			"metadata": [
+			  {
+				"name": "thiru-tf-2"
+			  }
+			],
+			"spec": [
+			  {
+				"managed_cluster": [
+				  {
+					"api_version": "2022-07-01",
+					"location": "centralindia",
+					"properties": [
+					  {
+						"dns_prefix": "testuser-test-dns",
+						"kubernetes_version": "1.28.5"
+					  }
+					],
+					"type": "Microsoft.ContainerService/managedClusters"
+				  }
+				],
+				"node_pools": [
+				  {
+					"api_version": "2022-07-01",
+					"location": "centralindia",
+					"name": "pool1",
+					"properties": [
+					  {
+						"count": 1,
+						"mode": "System",
+						"vm_size": "Standard_B2s"
+					  }
+					],
+					"type": "Microsoft.ContainerService/managedClusters/agentPools"
+				  }
+				],
+				"resource_group_name": "gautham-rg-ci",
+				"subscription_id": ""
+			  }
+			]
+		  }
+		],
+		"proxy_config": [],
+		"sharing": [],
+		"system_components_placement": [
+		  {
+			"daemon_set_override": [
+			  {
+				"node_selection_enabled": true,
+				"tolerations": []
+			  }
+			],
+			"node_selector": {
+			  "role": "system"
+			},
+			"tolerations": []
+		  }
+		],
+		"type": "aks"
+	  }
+	],
+	"timeouts": null
+}
+`
+
+func TestMoveToV3(t *testing.T) {
+	v1State := make(map[string]interface{}, 0)
+	if err := json.Unmarshal([]byte(v1StateJsonStr), &v1State); err != nil {
+		t.Fatalf("Failed to unmarshal v1 state: %v", err)
+	}
+	v3State := make(map[string]interface{}, 0)
+	if err := json.Unmarshal([]byte(v3StateJsonStr), &v3State); err != nil {
+		t.Fatalf("Failed to unmarshal v3 state: %v", err)
+	}
+	movedState, err := toV3.Move(v1State)
+	if err != nil {
+		t.Fatalf("Failed to move to V3: %v", err)
+	}
+	if !reflect.DeepEqual(movedState, v3State) {
+		got, _ := json.MarshalIndent(movedState, "", "  ")
+		t.Fatalf("Move failed, expected: %v, got: %s", v3State, got)
+	}
+}
+
+func TestMoveToV3InvalidState(t *testing.T) {
+	for name, state := range map[string]string{
+		"no spec":           `{"metadata": [{"name": "c1"}]}`,
+		"no cluster config": `{"metadata": [{"name": "c1"}], "spec": [{"type": "aks"}]}`,
+		"no name":           `{"metadata": [{}], "spec": [{"cluster_config": [{"spec": [{}]}]}]}`,
+	} {
+		rawState := make(map[string]interface{}, 0)
+		if err := json.Unmarshal([]byte(state), &rawState); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := toV3.Move(rawState); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package rafay
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/RafaySystems/terraform-provider-rafay/rafay/migrate/aks/toV3"
+)
+
+// stateMover translates the state of a sourceType resource into the layout
+// of the resource it is registered for in stateMovers.
+type stateMover struct {
+	sourceType string
+	move       func(rawState map[string]interface{}) (map[string]interface{}, error)
+}
+
+// stateMovers are the moved blocks between resource types the provider
+// supports, by target resource type.
+var stateMovers = map[string]stateMover{
+	"rafay_aks_cluster_v3": {sourceType: toV3.SourceType, move: toV3.Move},
+}
+
+// WithMoveState serves p with support for moved blocks between the resource
+// types in stateMovers, which the SDK does not offer on its own. The rest of
+// the protocol is served by p as is.
+func WithMoveState(p *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &moveStateServer{ProviderServer: p.GRPCProvider(), provider: p}
+	}
+}
+
+type moveStateServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+}
+
+// MoveResourceState upgrades the source state to the current schema of its
+// resource, translates it with the stateMover of the target and has the SDK
+// decode the result against the target schema, dropping the attributes the
+// target does not have.
+func (s *moveStateServer) MoveResourceState(ctx context.Context, req *tfprotov5.MoveResourceStateRequest) (*tfprotov5.MoveResourceStateResponse, error) {
+	mover, ok := stateMovers[req.TargetTypeName]
+	if !ok || req.SourceTypeName != mover.sourceType {
+		return s.ProviderServer.MoveResourceState(ctx, req)
+	}
+	resp := &tfprotov5.MoveResourceStateResponse{}
+
+	upgraded, err := s.ProviderServer.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: req.SourceTypeName,
+		Version:  req.SourceSchemaVersion,
+		RawState: req.SourceState,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if hasErrorDiagnostics(upgraded.Diagnostics) || upgraded.UpgradedState == nil {
+		resp.Diagnostics = append(upgraded.Diagnostics, moveStateError(req, fmt.Errorf("unable to upgrade the %s state", req.SourceTypeName)))
+		return resp, nil
+	}
+
+	sourceType := s.provider.ResourcesMap[req.SourceTypeName].CoreConfigSchema().ImpliedType()
+	val, err := msgpack.Unmarshal(upgraded.UpgradedState.MsgPack, sourceType)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError(req, err))
+		return resp, nil
+	}
+	sourceJSON, err := ctyjson.Marshal(val, sourceType)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError(req, err))
+		return resp, nil
+	}
+	rawState := map[string]interface{}{}
+	if err := json.Unmarshal(sourceJSON, &rawState); err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError(req, err))
+		return resp, nil
+	}
+
+	moved, err := mover.move(rawState)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError(req, err))
+		return resp, nil
+	}
+	targetJSON, err := json.Marshal(moved)
+	if err != nil {
+		resp.Diagnostics = append(resp.Diagnostics, moveStateError(req, err))
+		return resp, nil
+	}
+
+	target, err := s.ProviderServer.UpgradeResourceState(ctx, &tfprotov5.UpgradeResourceStateRequest{
+		TypeName: req.TargetTypeName,
+		Version:  int64(s.provider.ResourcesMap[req.TargetTypeName].SchemaVersion),
+		RawState: &tfprotov5.RawState{JSON: targetJSON},
+	})
+	if err != nil {
+		return nil, err
+	}
+	resp.Diagnostics = target.Diagnostics
+	resp.TargetState = target.UpgradedState
+	return resp, nil
+}
+
+func moveStateError(req *tfprotov5.MoveResourceStateRequest, err error) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  "Unable to Move Resource State",
+		Detail:   fmt.Sprintf("Unable to move the %s state to %s: %s", req.SourceTypeName, req.TargetTypeName, err),
+	}
+}
+
+func hasErrorDiagnostics(diags []*tfprotov5.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
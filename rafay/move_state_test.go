@@ -0,0 +1,114 @@
+package rafay
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+const aksClusterStateJSON = `{
+	"apiversion": "rafay.io/v1alpha1",
+	"id": "rx28oml",
+	"kind": "Cluster",
+	"metadata": [{"labels": {"env": "dev"}, "name": "c1", "project": "defaultproject"}],
+	"spec": [{
+		"blueprint": "minimal",
+		"blueprintversion": "",
+		"cloudprovider": "azure-creds",
+		"cluster_config": [{
+			"apiversion": "rafay.io/v1alpha1",
+			"kind": "aksClusterConfig",
+			"metadata": [{"name": "c1"}],
+			"spec": [{
+				"managed_cluster": [{
+					"apiversion": "2022-07-01",
+					"location": "centralindia",
+					"properties": [{"dns_prefix": "c1-dns", "kubernetes_version": "1.28.5"}],
+					"type": "Microsoft.ContainerService/managedClusters"
+				}],
+				"node_pools": [{
+					"apiversion": "2022-07-01",
+					"location": "centralindia",
+					"name": "pool1",
+					"properties": [{"count": 1, "mode": "System", "vm_size": "Standard_B2s"}],
+					"type": "Microsoft.ContainerService/managedClusters/agentPools"
+				}],
+				"resource_group_name": "rg1"
+			}]
+		}],
+		"type": "aks"
+	}]
+}`
+
+func TestMoveAKSClusterState(t *testing.T) {
+	p := New("test")()
+	server := WithMoveState(p)()
+
+	resp, err := server.MoveResourceState(context.Background(), &tfprotov5.MoveResourceStateRequest{
+		SourceProviderAddress: "registry.terraform.io/RafaySystems/rafay",
+		SourceTypeName:        "rafay_aks_cluster",
+		SourceSchemaVersion:   int64(p.ResourcesMap["rafay_aks_cluster"].SchemaVersion),
+		SourceState:           &tfprotov5.RawState{JSON: []byte(aksClusterStateJSON)},
+		TargetTypeName:        "rafay_aks_cluster_v3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Errorf("%s: %s", d.Summary, d.Detail)
+	}
+	if resp.TargetState == nil {
+		t.Fatal("no target state")
+	}
+
+	val, err := msgpack.Unmarshal(resp.TargetState.MsgPack, p.ResourcesMap["rafay_aks_cluster_v3"].CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := func(v cty.Value, attr string) cty.Value {
+		return v.GetAttr(attr).Index(cty.NumberIntVal(0))
+	}
+	spec := first(val, "spec")
+	configSpec := first(first(spec, "config"), "spec")
+	checks := map[string]string{
+		"id":                  val.GetAttr("id").AsString(),
+		"cloud_credentials":   spec.GetAttr("cloud_credentials").AsString(),
+		"blueprint":           first(spec, "blueprint_config").GetAttr("name").AsString(),
+		"resource_group":      configSpec.GetAttr("resource_group_name").AsString(),
+		"node_pool_api":       first(configSpec, "node_pools").GetAttr("api_version").AsString(),
+		"metadata.project":    first(val, "metadata").GetAttr("project").AsString(),
+		"metadata.labels.env": first(val, "metadata").GetAttr("labels").Index(cty.StringVal("env")).AsString(),
+	}
+	want := map[string]string{
+		"id":                  "c1",
+		"cloud_credentials":   "azure-creds",
+		"blueprint":           "minimal",
+		"resource_group":      "rg1",
+		"node_pool_api":       "2022-07-01",
+		"metadata.project":    "defaultproject",
+		"metadata.labels.env": "dev",
+	}
+	for k, v := range want {
+		if checks[k] != v {
+			t.Errorf("%s: got %q, want %q", k, checks[k], v)
+		}
+	}
+}
+
+func TestMoveResourceStateUnsupported(t *testing.T) {
+	server := WithMoveState(New("test")())()
+	resp, err := server.MoveResourceState(context.Background(), &tfprotov5.MoveResourceStateRequest{
+		SourceTypeName: "rafay_eks_cluster_spec",
+		SourceState:    &tfprotov5.RawState{JSON: []byte(`{}`)},
+		TargetTypeName: "rafay_aks_cluster_v3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !hasErrorDiagnostics(resp.Diagnostics) {
+		t.Error("expected an error moving from an unsupported resource type")
+	}
+}
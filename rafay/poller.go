@@ -0,0 +1,209 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	dynamic "github.com/RafaySystems/rafay-common/pkg/hub/client/dynamic"
+	"github.com/RafaySystems/terraform-provider-rafay/internal/transport"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultPollMinInterval        = 10 * time.Second
+	defaultPollMaxInterval        = 60 * time.Second
+	defaultPollMaxTransientErrors = 5
+
+	// pollJitter is the fraction by which every poll delay is randomly
+	// shortened or lengthened, so parallel resources don't poll in lockstep.
+	pollJitter = 0.2
+)
+
+// errPollTimeout is wrapped by the error pollUntil returns when the wait
+// ran out of time before the operation completed.
+var errPollTimeout = errors.New("timed out")
+
+// pollSpec describes how to wait for a long-running console operation.
+type pollSpec struct {
+	// Operation names the wait in progress messages, e.g. "workload publish".
+	Operation string
+	// Timeout bounds the wait in addition to any deadline already on the
+	// context, typically d.Timeout(schema.TimeoutCreate). Zero means only
+	// the context deadline applies.
+	Timeout time.Duration
+	// InitialDelay is waited before the first poll.
+	InitialDelay time.Duration
+	// MinInterval and MaxInterval bound the delay between polls. The delay
+	// starts at MinInterval and doubles after every pending poll.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+	// MaxTransientErrors is how many consecutive transient errors are
+	// tolerated before the wait fails.
+	MaxTransientErrors int
+}
+
+// pollFunc checks the operation once. It reports whether the operation has
+// completed, plus a short status for progress messages. A returned error
+// ends the wait unless isTransientError considers it retryable.
+type pollFunc func(ctx context.Context) (done bool, status string, err error)
+
+// pollUntil calls poll until it reports completion, it returns a
+// non-transient error, or the wait runs out of time. Delays between polls
+// back off exponentially with jitter, and every poll is reported through
+// tflog so waits can be followed with TF_LOG=INFO.
+func pollUntil(ctx context.Context, spec pollSpec, poll pollFunc) error {
+	spec = spec.withDefaults()
+	if spec.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, spec.Timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	interval := spec.MinInterval
+	delay := spec.InitialDelay
+	lastStatus := "not started"
+	transientErrors := 0
+
+	for attempt := 1; ; attempt++ {
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return pollContextError(ctx, spec, start, lastStatus)
+			case <-timer.C:
+			}
+		}
+
+		done, status, err := poll(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return pollContextError(ctx, spec, start, lastStatus)
+			}
+			if !isTransientError(err) {
+				return err
+			}
+			transientErrors++
+			if transientErrors > spec.MaxTransientErrors {
+				return fmt.Errorf("%s: giving up after %d consecutive errors: %w", spec.Operation, transientErrors, err)
+			}
+			tflog.Warn(ctx, "transient error while polling, retrying", map[string]any{
+				"operation": spec.Operation,
+				"attempt":   attempt,
+				"error":     err.Error(),
+			})
+		} else {
+			transientErrors = 0
+			if status != "" {
+				lastStatus = status
+			}
+			if done {
+				tflog.Info(ctx, "operation completed", map[string]any{
+					"operation": spec.Operation,
+					"attempts":  attempt,
+					"elapsed":   time.Since(start).Round(time.Second).String(),
+					"status":    lastStatus,
+				})
+				return nil
+			}
+		}
+
+		delay = withJitter(interval)
+		tflog.Info(ctx, "operation in progress", map[string]any{
+			"operation":  spec.Operation,
+			"attempt":    attempt,
+			"elapsed":    time.Since(start).Round(time.Second).String(),
+			"status":     lastStatus,
+			"next_check": delay.Round(time.Second).String(),
+		})
+		interval *= 2
+		if interval > spec.MaxInterval {
+			interval = spec.MaxInterval
+		}
+	}
+}
+
+func (s pollSpec) withDefaults() pollSpec {
+	if s.Operation == "" {
+		s.Operation = "operation"
+	}
+	if s.MinInterval <= 0 {
+		s.MinInterval = defaultPollMinInterval
+	}
+	if s.MaxInterval <= 0 {
+		s.MaxInterval = defaultPollMaxInterval
+	}
+	if s.MaxInterval < s.MinInterval {
+		s.MaxInterval = s.MinInterval
+	}
+	if s.MaxTransientErrors <= 0 {
+		s.MaxTransientErrors = defaultPollMaxTransientErrors
+	}
+	return s
+}
+
+func pollContextError(ctx context.Context, spec pollSpec, start time.Time, lastStatus string) error {
+	elapsed := time.Since(start).Round(time.Second)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w waiting for %s after %s (last status: %s)", errPollTimeout, spec.Operation, elapsed, lastStatus)
+	}
+	return fmt.Errorf("%s cancelled after %s (last status: %s): %w", spec.Operation, elapsed, lastStatus, ctx.Err())
+}
+
+func withJitter(d time.Duration) time.Duration {
+	return time.Duration(float64(d) * (1 + pollJitter*(2*rand.Float64()-1)))
+}
+
+// transientErrorMessages are fragments of error messages returned by the
+// hub and rctl clients for failures that are worth retrying.
+var transientErrorMessages = []string{
+	"code 429",
+	"code 500",
+	"code 502",
+	"code 503",
+	"code 504",
+	"connection reset by peer",
+	"connection refused",
+	"tls handshake timeout",
+	"i/o timeout",
+	"unexpected eof",
+}
+
+// isTransientError reports whether err is a throttling response, a server
+// side failure or a dropped connection, i.e. whether retrying the same
+// request may succeed.
+func isTransientError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var dErr *dynamic.DynamicClientGetError
+	if errors.As(err, &dErr) && dErr != nil {
+		return isTransientStatus(dErr.StatusCode)
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	for _, fragment := range transientErrorMessages {
+		if strings.Contains(msg, fragment) {
+			return true
+		}
+	}
+	return false
+}
+
+func isTransientStatus(code int) bool {
+	return transport.IsRetryableStatus(code)
+}
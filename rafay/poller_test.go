@@ -0,0 +1,105 @@
+package rafay
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func fastPollSpec() pollSpec {
+	return pollSpec{
+		Operation:   "test operation",
+		MinInterval: time.Millisecond,
+		MaxInterval: 4 * time.Millisecond,
+	}
+}
+
+func TestPollUntilCompletes(t *testing.T) {
+	calls := 0
+	err := pollUntil(context.Background(), fastPollSpec(), func(ctx context.Context) (bool, string, error) {
+		calls++
+		return calls == 3, fmt.Sprintf("poll %d", calls), nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("polled %d times, want 3", calls)
+	}
+}
+
+func TestPollUntilRetriesTransientErrors(t *testing.T) {
+	calls := 0
+	err := pollUntil(context.Background(), fastPollSpec(), func(ctx context.Context) (bool, string, error) {
+		calls++
+		if calls < 3 {
+			return false, "", errors.New("request failed with code 503")
+		}
+		return true, "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPollUntilGivesUpOnRepeatedTransientErrors(t *testing.T) {
+	spec := fastPollSpec()
+	spec.MaxTransientErrors = 2
+	calls := 0
+	err := pollUntil(context.Background(), spec, func(ctx context.Context) (bool, string, error) {
+		calls++
+		return false, "", errors.New("connection reset by peer")
+	})
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls != 3 {
+		t.Errorf("polled %d times, want 3", calls)
+	}
+}
+
+func TestPollUntilStopsOnPermanentError(t *testing.T) {
+	failure := errors.New("failed to publish workload")
+	calls := 0
+	err := pollUntil(context.Background(), fastPollSpec(), func(ctx context.Context) (bool, string, error) {
+		calls++
+		return false, "", failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("got %v, want %v", err, failure)
+	}
+	if calls != 1 {
+		t.Errorf("polled %d times, want 1", calls)
+	}
+}
+
+func TestPollUntilTimesOut(t *testing.T) {
+	spec := fastPollSpec()
+	spec.Timeout = 20 * time.Millisecond
+	err := pollUntil(context.Background(), spec, func(ctx context.Context) (bool, string, error) {
+		return false, "still provisioning", nil
+	})
+	if !errors.Is(err, errPollTimeout) {
+		t.Fatalf("got %v, want a timeout", err)
+	}
+}
+
+func TestIsTransientError(t *testing.T) {
+	cases := map[string]bool{
+		"code 429 too many requests":     true,
+		"code 502 bad gateway":           true,
+		"read: connection reset by peer": true,
+		"code 404 not found":             false,
+		"failed to publish workload":     false,
+	}
+	for msg, want := range cases {
+		if got := isTransientError(errors.New(msg)); got != want {
+			t.Errorf("isTransientError(%q) = %v, want %v", msg, got, want)
+		}
+	}
+	if isTransientError(context.DeadlineExceeded) {
+		t.Error("context deadline must not be retried")
+	}
+}
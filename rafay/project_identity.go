@@ -0,0 +1,98 @@
+package rafay
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// projectIdentitySchema is the resource identity of an object scoped to a
+// project: its name and project, as in the `name/project` import ID.
+func projectIdentitySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Description:       "Name of the object",
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+		},
+		"project": {
+			Description:       "Project of the object",
+			Type:              schema.TypeString,
+			RequiredForImport: true,
+		},
+	}
+}
+
+// withProjectIdentity adds the project identity to r. The identity is what
+// `terraform query` lists and what an `import` block with `identity` names,
+// so it is set after every successful create, read and update, and an
+// import by identity is turned into the `name/project` import ID the
+// importer of r expects.
+func withProjectIdentity(r *schema.Resource) *schema.Resource {
+	r.Identity = &schema.ResourceIdentity{
+		SchemaFunc: projectIdentitySchema,
+	}
+
+	create, read, update := r.CreateContext, r.ReadContext, r.UpdateContext
+	r.CreateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := create(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, setProjectIdentity(d)...)
+	}
+	r.ReadContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := read(ctx, d, m)
+		if diags.HasError() || d.Id() == "" {
+			return diags
+		}
+		return append(diags, setProjectIdentity(d)...)
+	}
+	r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+		diags := update(ctx, d, m)
+		if diags.HasError() {
+			return diags
+		}
+		return append(diags, setProjectIdentity(d)...)
+	}
+
+	state, stateContext := r.Importer.State, r.Importer.StateContext
+	r.Importer = &schema.ResourceImporter{
+		StateContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+			if d.Id() == "" {
+				identity, err := d.Identity()
+				if err != nil {
+					return nil, err
+				}
+				name, _ := identity.Get("name").(string)
+				project, _ := identity.Get("project").(string)
+				if name == "" || project == "" {
+					return nil, fmt.Errorf("the identity of the imported object needs a name and a project")
+				}
+				d.SetId(name + "/" + project)
+			}
+			if stateContext != nil {
+				return stateContext(ctx, d, m)
+			}
+			return state(d, m)
+		},
+	}
+	return r
+}
+
+// setProjectIdentity sets the identity of d from its metadata.
+func setProjectIdentity(d *schema.ResourceData) diag.Diagnostics {
+	identity, err := d.Identity()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := identity.Set("name", d.Get("metadata.0.name")); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := identity.Set("project", d.Get("metadata.0.project")); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
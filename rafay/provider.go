@@ -2,9 +2,7 @@ package rafay
 
 import (
 	"context"
-	"crypto/tls"
 	"log"
-	"net/http"
 	"os"
 	"os/user"
 	"path/filepath"
@@ -12,6 +10,7 @@ import (
 
 	rctlconfig "github.com/RafaySystems/rctl/pkg/config"
 	rctlcontext "github.com/RafaySystems/rctl/pkg/context"
+	"github.com/RafaySystems/terraform-provider-rafay/internal/transport"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -49,6 +48,26 @@ func Schema() map[string]*schema.Schema {
 			Optional:    true,
 			DefaultFunc: schema.EnvDefaultFunc("RCTL_PROJECT", nil),
 		},
+		"max_retries": {
+			Type:        schema.TypeInt,
+			Description: "Maximum number of retries of a Rafay API request failing with a throttling, server or connection error. Requests that create or trigger something are only retried when the console refused them",
+			Optional:    true,
+		},
+		"retry_max_wait": {
+			Type:        schema.TypeString,
+			Description: "Maximum wait before retrying a failed Rafay API request, e.g. 30s",
+			Optional:    true,
+		},
+		"requests_per_second": {
+			Type:        schema.TypeFloat,
+			Description: "Maximum sustained rate of Rafay API requests, shared by all resources. 0 means unlimited",
+			Optional:    true,
+		},
+		"max_concurrent_requests": {
+			Type:        schema.TypeInt,
+			Description: "Maximum number of Rafay API requests in flight at once. 0 means unlimited",
+			Optional:    true,
+		},
 	}
 }
 
@@ -70,7 +89,6 @@ func New(_ string) func() *schema.Provider {
 				"rafay_import_cluster":                resourceImportCluster(),
 				"rafay_cluster_override":              resourceClusterOverride(),
 				"rafay_workload":                      resourceWorkload(),
-				"rafay_namespace":                     resourceNamespace(),
 				"rafay_repositories":                  resourceRepositories(),
 				"rafay_agent":                         resourceAgent(),
 				"rafay_agent_pool":                    resourceAgentPool(),
@@ -231,9 +249,50 @@ func ProviderConfigure(ctx context.Context, rd *schema.ResourceData) (interface{
 		return nil, diags
 	}
 
+	// -1 and nil tell an unset setting apart from an explicit 0
+	maxRetries := -1
+	var requestsPerSecond *float64
+	var maxConcurrent *int
+	if raw := rd.GetRawConfig(); raw.IsKnown() && !raw.IsNull() {
+		if !raw.GetAttr("max_retries").IsNull() {
+			maxRetries = rd.Get("max_retries").(int)
+		}
+		if !raw.GetAttr("requests_per_second").IsNull() {
+			v := rd.Get("requests_per_second").(float64)
+			requestsPerSecond = &v
+		}
+		if !raw.GetAttr("max_concurrent_requests").IsNull() {
+			v := rd.Get("max_concurrent_requests").(int)
+			maxConcurrent = &v
+		}
+	}
+	retryPolicy, err := transport.PolicyFromConfig(maxRetries, rd.Get("retry_max_wait").(string))
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	limits, err := transport.LimitsFromConfig(requestsPerSecond, maxConcurrent)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	transport.Configure(retryPolicy)
+	transport.ConfigureLimits(limits)
+
 	if ignoreTlsError {
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+		if err := transport.SetInsecureSkipVerify(true); err != nil {
+			return nil, diag.FromErr(err)
+		}
+	}
+
+	meta, err := newProviderMeta(rctlconfig.GetConfig())
+	if err != nil {
+		log.Printf("rafay provider client init error %s", err.Error())
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Unable to create rafay provider",
+			Detail:   "Unable to initialise the Rafay API client: " + err.Error(),
+		})
+		return nil, diags
 	}
 
-	return newProviderMeta(rctlconfig.GetConfig()), diags
+	return meta, diags
 }
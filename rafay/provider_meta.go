@@ -1,21 +1,53 @@
 package rafay
 
 import (
+	"fmt"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	rctlconfig "github.com/RafaySystems/rctl/pkg/config"
+	"github.com/RafaySystems/rctl/pkg/versioninfo"
+	"github.com/RafaySystems/terraform-provider-rafay/internal/transport"
 
 	v3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
 )
 
+// ProviderMeta is handed to every legacy SDK resource and data source as
+// their `m interface{}` argument. It owns the rctl config and a single hub
+// client built once in ProviderConfigure, so resources share one connection
+// pool and identical TLS, user agent and timeout settings.
 type ProviderMeta struct {
-	config                 *rctlconfig.Config
-	BlueprintClientFactory BlueprintClientFactory
+	config                   *rctlconfig.Config
+	client                   typed.Client
+	BlueprintClientFactory   BlueprintClientFactory
+	NamespaceClientFactory   NamespaceClientFactory
+	WorkloadClientFactory    WorkloadClientFactory
+	AddonClientFactory       AddonClientFactory
+	ProjectClientFactory     ProjectClientFactory
+	EnvironmentClientFactory EnvironmentClientFactory
+	CredentialsClientFactory CredentialsClientFactory
+	ClusterClientFactory     ClusterClientFactory
 }
 
 type BlueprintClientFactory func() (v3.BlueprintClient, error)
 
-func newProviderMeta(cfg *rctlconfig.Config) *ProviderMeta {
+func newProviderMeta(cfg *rctlconfig.Config) (*ProviderMeta, error) {
+	client, err := newHubClient(cfg)
+	if err != nil {
+		return nil, err
+	}
 	return &ProviderMeta{
 		config: cfg,
+		client: client,
+	}, nil
+}
+
+// ProviderMetaWithClient returns a meta sharing client, for the framework
+// provider calling into SDK resources, e.g. to read the objects it lists.
+func ProviderMetaWithClient(client typed.Client) *ProviderMeta {
+	return &ProviderMeta{
+		config: rctlconfig.GetConfig(),
+		client: client,
 	}
 }
 
@@ -25,3 +57,46 @@ func (p *ProviderMeta) Config() *rctlconfig.Config {
 	}
 	return p.config
 }
+
+// Client returns the shared hub client. Metas built without one (e.g. in
+// unit tests) fall back to a client for the current rctl auth profile.
+func (p *ProviderMeta) Client() (typed.Client, error) {
+	if p == nil || p.client == nil {
+		return newHubClient(rctlconfig.GetConfig())
+	}
+	return p.client, nil
+}
+
+// newHubClient builds a typed hub client from the auth profile of cfg. All
+// hub clients in this package are created here so they agree on user agent,
+// certificate verification, connection timeout and the retry policy and
+// limits of the transport package.
+func newHubClient(cfg *rctlconfig.Config) (typed.Client, error) {
+	return newHubClientWithKey(cfg, "")
+}
+
+// newHubClientWithKey is newHubClient authenticating with apiKey instead of
+// the key of the auth profile, e.g. for an impersonated user.
+func newHubClientWithKey(cfg *rctlconfig.Config, apiKey string) (typed.Client, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("rafay provider config is not initialized")
+	}
+	auth := cfg.GetAppAuthProfile()
+	if apiKey == "" {
+		apiKey = auth.Key
+	}
+	return typed.NewClientWithUserAgent(auth.URL, apiKey, versioninfo.GetUserAgent(),
+		options.WithInsecureSkipVerify(auth.SkipServerCertValid),
+		options.WithConnectionTimeout(CONN_TIMEOUT),
+		options.WithTransport(transport.ForHub(auth.SkipServerCertValid)))
+}
+
+// getHubClient returns the hub client carried by the provider meta m.
+// Resources must use this rather than constructing their own client.
+// Resources supporting `impersonate` use hubClientFromContext instead.
+func getHubClient(m interface{}) (typed.Client, error) {
+	if meta, ok := m.(*ProviderMeta); ok {
+		return meta.Client()
+	}
+	return newHubClient(rctlconfig.GetConfig())
+}
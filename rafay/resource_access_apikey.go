@@ -72,6 +72,9 @@ func resourceAccessApikey() *schema.Resource {
 		ReadContext:   resourceAccessApiRead,
 		UpdateContext: resourceAccessApiUpdate,
 		DeleteContext: resourceAccessApiDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAccessApiImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -106,6 +109,20 @@ func resourceAccessApikey() *schema.Resource {
 	}
 }
 
+// resourceAccessApiImport imports the API key resource of a user by user
+// name. The key itself can't be read back from the controller, so the
+// imported apikey and api_secret hold the recreate hint set by Read.
+func resourceAccessApiImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Println("resourceAccessApiImport id:", d.Id())
+	if d.Id() == "" {
+		return nil, fmt.Errorf("invalid id, expected the user name")
+	}
+	if err := d.Set("user_name", d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAccessApiCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("resource user create id %s", d.Id())
 	return resourceAccessApiUpsert(ctx, d, true)
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
@@ -25,7 +24,7 @@ var showArtifactFlag bool = false
 
 func ResourceAddon() *schema.Resource {
 	s := copySchemaMap(resource.AddonSchema.Schema)
-	return &schema.Resource{
+	return withProjectIdentity(withArtifactDigests(&schema.Resource{
 		CreateContext: resourceAddonCreate,
 		ReadContext:   resourceAddonRead,
 		UpdateContext: resourceAddonUpdate,
@@ -42,7 +41,7 @@ func ResourceAddon() *schema.Resource {
 
 		SchemaVersion: 1,
 		Schema:        s,
-	}
+	}))
 }
 
 func resourceAddonImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -79,7 +78,7 @@ func resourceAddonImport(d *schema.ResourceData, meta interface{}) ([]*schema.Re
 
 func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("addon create starts")
-	create := isAddonAlreadyExists(ctx, d)
+	create := isAddonAlreadyExists(ctx, d, m)
 	diags := resourceAddonUpsert(ctx, d, m)
 	if diags.HasError() && len(diags) > 0 && !create {
 		diagsError := diags[0]
@@ -94,13 +93,12 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, m interfac
 				log.Printf("addon expandAddon error")
 				return diags
 			}
-			auth := config.GetConfig().GetAppAuthProfile()
-			client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+			client, err := addonClient(ctx, m)
 			if err != nil {
 				return diags
 			}
 
-			err = client.InfraV3().Addon().Delete(ctx, options.DeleteOptions{
+			err = client.Delete(ctx, options.DeleteOptions{
 				Name:    ns.Metadata.Name,
 				Project: ns.Metadata.Project,
 			})
@@ -140,13 +138,12 @@ func resourceAddonUpsert(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := addonClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.InfraV3().Addon().Apply(ctx, addon, options.ApplyOptions{})
+	err = client.Apply(ctx, addon, options.ApplyOptions{})
 	if err != nil {
 		// XXX Debug
 		n1 := spew.Sprintf("%+v", addon)
@@ -171,13 +168,12 @@ func resourceAddonDelete(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := addonClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.InfraV3().Addon().Delete(ctx, options.DeleteOptions{
+	err = client.Delete(ctx, options.DeleteOptions{
 		Name:    addon.Metadata.Name,
 		Project: addon.Metadata.Project,
 	})
@@ -216,13 +212,12 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, m interface{
 	// w1 := spew.Sprintf("%+v", tfAddonState)
 	// log.Println("resourceAddonRead tfAddonState", w1)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := addonClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	addon, err := client.InfraV3().Addon().Get(ctx, options.GetOptions{
+	addon, err := client.Get(ctx, options.GetOptions{
 		Name: meta.Name,
 		//Name:    tfAddonState.Metadata.Name,
 		Project: meta.Project,
@@ -484,20 +479,19 @@ func flattenAddonVersionState(in string, p []interface{}) string {
 	return ""
 }
 
-func isAddonAlreadyExists(ctx context.Context, d *schema.ResourceData) bool {
+func isAddonAlreadyExists(ctx context.Context, d *schema.ResourceData, m interface{}) bool {
 
 	meta := GetMetaData(d)
 	if meta == nil {
 		return false
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := addonClient(ctx, m)
 	if err != nil {
 		return false
 	}
 
-	_, err = client.InfraV3().Addon().Get(ctx, options.GetOptions{
+	_, err = client.Get(ctx, options.GetOptions{
 		Name:    meta.Name,
 		Project: meta.Project,
 	})
@@ -9,12 +9,10 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/gitopspb"
 	"github.com/RafaySystems/rctl/pkg/agent"
 	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/user"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -34,6 +32,9 @@ func resourceAgent() *schema.Resource {
 		ReadContext:   resourceAgentRead,
 		UpdateContext: resourceAgentUpdate,
 		DeleteContext: resourceAgentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -61,26 +62,12 @@ func resourceAgentCreate(ctx context.Context, d *schema.ResourceData, m interfac
 			return diags
 		}
 
-		if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-			defer ResetImpersonateUser()
-			asUser := d.Get("impersonate").(string)
-			// check user role : impersonation not allowed for a user
-			// with ORG Admin role
-			isOrgAdmin, err := user.IsOrgAdmin(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			if isOrgAdmin {
-				return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-			}
-			config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		ctx, err = withImpersonation(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := hubClientFromContext(ctx, m)
 		if err != nil {
 			return diags
 		}
@@ -124,26 +111,12 @@ func resourceAgentUpsert(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -188,26 +161,12 @@ func resourceAgentRead(ctx context.Context, d *schema.ResourceData, m interface{
 	// w1 := spew.Sprintf("%+v", tfAgentState)
 	// log.Println("resourceAgentRead tfAgentState", w1)
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -250,26 +209,12 @@ func resourceAgentDelete(ctx context.Context, d *schema.ResourceData, m interfac
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
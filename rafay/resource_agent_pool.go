@@ -9,11 +9,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/gitopspb"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -28,6 +26,9 @@ func resourceAgentPool() *schema.Resource {
 		ReadContext:   resourceAgentPoolRead,
 		UpdateContext: resourceAgentPoolUpdate,
 		DeleteContext: resourceAgentPoolDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -55,8 +56,7 @@ func resourceAgentPoolCreate(ctx context.Context, d *schema.ResourceData, m inte
 			return diags
 		}
 
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -100,8 +100,7 @@ func resourceAgentPoolUpsert(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -142,8 +141,7 @@ func resourceAgentPoolRead(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -188,8 +186,7 @@ func resourceAgentPoolDelete(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -57,6 +57,9 @@ func resourceAKSCluster() *schema.Resource {
 		ReadContext:   resourceAKSClusterRead,
 		UpdateContext: resourceAKSClusterUpdate,
 		DeleteContext: resourceAKSClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAKSClusterImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(100 * time.Minute),
@@ -7087,6 +7090,13 @@ func collectAKSUpsertErrors(operations []*clusterCTLOperation) (string, error) {
 	return "\n" + collectErrs, nil
 }
 
+// resourceAKSClusterImport imports a cluster by `name/project`. The resource
+// ID is the cluster ID, so the cluster is looked up here; Read rebuilds the
+// rest of the configuration from the deployed cluster spec.
+func resourceAKSClusterImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return importClusterByMetadata(d)
+}
+
 func resourceAKSClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("create AKS cluster resource")
 	return resourceAKSClusterUpsert(ctx, d, m)
@@ -45,6 +45,9 @@ func resourceAKSClusterSpec() *schema.Resource {
 		ReadContext:   resourceAKSClusterSpecRead,
 		UpdateContext: resourceAKSClusterSpecUpdate,
 		DeleteContext: resourceAKSClusterSpecDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceAKSClusterSpecImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -236,6 +239,10 @@ func resourceAKSClusterSpecUpsert(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func resourceAKSClusterSpecImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return importClusterByName(d, "name", "projectname")
+}
+
 func resourceAKSClusterSpecCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Printf("create AKS cluster resource")
 	return resourceAKSClusterSpecUpsert(ctx, d, m)
@@ -15,13 +15,10 @@ import (
 	"github.com/RafaySystems/edge-common/pkg/models/edge"
 	dynamic "github.com/RafaySystems/rafay-common/pkg/hub/client/dynamic"
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
 	"github.com/RafaySystems/rctl/pkg/cluster"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -62,7 +59,7 @@ func resourceAKSClusterV3Read(ctx context.Context, d *schema.ResourceData, m int
 
 	log.Println("resourceClusterRead ")
 
-	deployedCluster, err := getDeployedClusterSpecV3(ctx, d)
+	deployedCluster, err := getDeployedClusterSpecV3(ctx, d, m)
 	if err != nil {
 		log.Printf("resourceAKSClusterV3Read get cluster error %s", err.Error())
 		if IsResourceNotFoundErr(err) {
@@ -118,7 +115,7 @@ func resourceAKSClusterV3Read(ctx context.Context, d *schema.ResourceData, m int
 	return diags
 }
 
-func getDeployedClusterSpecV3(ctx context.Context, d *schema.ResourceData) (*infrapb.Cluster, error) {
+func getDeployedClusterSpecV3(ctx context.Context, d *schema.ResourceData, m interface{}) (*infrapb.Cluster, error) {
 	var deployedCluster *infrapb.Cluster
 
 	log.Println("getDeployedClusterSpecV3")
@@ -131,13 +128,12 @@ func getDeployedClusterSpecV3(ctx context.Context, d *schema.ResourceData) (*inf
 		return deployedCluster, err
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return deployedCluster, err
 	}
 
-	deployedCluster, err = client.InfraV3().Cluster().Get(ctx, options.GetOptions{
+	deployedCluster, err = client.Get(ctx, options.GetOptions{
 		Name:    desiredTfClusterState.Metadata.Name,
 		Project: desiredTfClusterState.Metadata.Project,
 	})
@@ -201,13 +197,12 @@ func resourceAKSClusterV3Delete(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.InfraV3().Cluster().Delete(ctx, options.DeleteOptions{
+	err = client.Delete(ctx, options.DeleteOptions{
 		Name:    ag.Metadata.Name,
 		Project: ag.Metadata.Project,
 	})
@@ -216,35 +211,31 @@ func resourceAKSClusterV3Delete(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
-	defer ticker.Stop()
-
 	edgeName := ag.Metadata.Name
 	projectName := ag.Metadata.Project
 
-LOOP:
-	for {
-		select {
-		case <-ctx.Done():
-			log.Printf("Cluster Deletion for edgename: %s and projectname: %s got timeout out.", edgeName, projectName)
-			return diag.FromErr(fmt.Errorf("cluster deletion for edgename: %s and projectname: %s got timeout out", edgeName, projectName))
-		case <-ticker.C:
-			_, err := client.InfraV3().Cluster().Get(ctx, options.GetOptions{
-				Name:    edgeName,
-				Project: projectName,
-			})
-			if dErr, ok := err.(*dynamic.DynamicClientGetError); ok && dErr != nil {
-				switch dErr.StatusCode {
-				case http.StatusNotFound:
-					log.Printf("Cluster Deletion completes for edgename: %s and projectname: %s", edgeName, projectName)
-					break LOOP
-				default:
-					log.Printf("Cluster Deletion failed for edgename: %s and projectname: %s with error: %s", edgeName, projectName, dErr.Error())
-					return diag.FromErr(dErr)
-				}
+	err = pollUntil(ctx, pollSpec{
+		Operation:    fmt.Sprintf("AKS cluster %s/%s deletion", projectName, edgeName),
+		InitialDelay: 30 * time.Second,
+		MinInterval:  30 * time.Second,
+		MaxInterval:  60 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		_, err := client.Get(ctx, options.GetOptions{
+			Name:    edgeName,
+			Project: projectName,
+		})
+		if dErr, ok := err.(*dynamic.DynamicClientGetError); ok && dErr != nil {
+			if dErr.StatusCode == http.StatusNotFound {
+				log.Printf("Cluster Deletion completes for edgename: %s and projectname: %s", edgeName, projectName)
+				return true, "deleted", nil
 			}
-			log.Printf("Cluster Deletion is in progress for edgename: %s and projectname: %s", edgeName, projectName)
+			log.Printf("Cluster Deletion failed for edgename: %s and projectname: %s with error: %s", edgeName, projectName, dErr.Error())
+			return false, "", dErr
 		}
+		return false, "deletion in progress", nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
@@ -302,7 +293,7 @@ func resourceAKSClusterV3Upsert(ctx context.Context, d *schema.ResourceData, m i
 
 		log.Println("Including first class edge resources in desired spec")
 
-		deployedCluster, err := getDeployedClusterSpecV3(ctx, d)
+		deployedCluster, err := getDeployedClusterSpecV3(ctx, d, m)
 		if err != nil {
 			log.Println("error getting deployed cluster", err)
 			return diag.FromErr(err)
@@ -323,13 +314,12 @@ func resourceAKSClusterV3Upsert(ctx context.Context, d *schema.ResourceData, m i
 
 	log.Println(">>>>>> CLUSTER: ", desiredCluster)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.InfraV3().Cluster().Apply(ctx, desiredCluster, options.ApplyOptions{})
+	err = client.Apply(ctx, desiredCluster, options.ApplyOptions{})
 	if err != nil {
 		// XXX Debug
 		n1 := spew.Sprintf("%+v", desiredCluster)
@@ -337,78 +327,76 @@ func resourceAKSClusterV3Upsert(ctx context.Context, d *schema.ResourceData, m i
 		log.Printf("Cluster apply error")
 		return diag.FromErr(err)
 	}
-	// wait for cluster creation
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
-	defer ticker.Stop()
-
 	edgeName := desiredCluster.Metadata.Name
 	projectName := desiredCluster.Metadata.Project
 	d.SetId(desiredCluster.Metadata.Name)
 
+	// wait for cluster creation
 	var warnings []string
-LOOP:
-	for {
-		select {
-		case <-ctx.Done():
-			log.Printf("Cluster operation timed out for edgeName: %s and projectname: %s", edgeName, projectName)
-			return diag.FromErr(fmt.Errorf("cluster operation timed out for edgeName: %s and projectname: %s", edgeName, projectName))
-		case <-ticker.C:
-			uCluster, err2 := client.InfraV3().Cluster().Status(ctx, options.StatusOptions{
-				Name:    edgeName,
-				Project: projectName,
-			})
-			if err2 != nil {
-				log.Printf("Fetching cluster having edgename: %s and projectname: %s failing due to err: %v", edgeName, projectName, err2)
-				return diag.FromErr(err2)
-			} else if uCluster == nil {
-				log.Printf("Cluster operation has not started with edgename: %s and projectname: %s", edgeName, projectName)
-			} else if uCluster.Status != nil && uCluster.Status.Aks != nil && uCluster.Status.CommonStatus != nil {
-				edgeId := uCluster.Status.Id
-				projectId, err := getProjectIDFromName(projectName)
-				if err != nil {
-					log.Print("error converting project name to id")
-					return diag.Errorf("error converting project name to project ID")
-				}
-				// aksStatus := uCluster.Status.Aks
-				uClusterCommonStatus := uCluster.Status.CommonStatus
-				switch uClusterCommonStatus.ConditionStatus {
-				case commonpb.ConditionStatus_StatusSubmitted:
-					log.Printf("Cluster operation not completed for edgename: %s and projectname: %s. Waiting 60 seconds more for cluster to complete the operation.", edgeName, projectName)
-				case commonpb.ConditionStatus_StatusOK:
-					log.Println("Checking in cluster conditions for blueprint sync success..")
-					conditionsFailure, clusterReadiness, err := getClusterConditions(edgeId, projectId)
-					if err != nil {
-						log.Printf("error while getCluster %s", err.Error())
-						return diag.FromErr(err)
-					}
-					if conditionsFailure {
-						log.Printf("blueprint sync failed for edgename: %s and projectname: %s", edgeName, projectName)
-						return diag.FromErr(fmt.Errorf("blueprint sync failed for edgename: %s and projectname: %s", edgeName, projectName))
-					} else if clusterReadiness {
-						tasksets := uCluster.Status.LastTasksets
-						if len(tasksets) > 0 {
-							lastTaskset := tasksets[0]
-							for _, taskOp := range lastTaskset.TasksetOperations {
-								if strings.Compare(taskOp.OperationName, edge.ClusterUpgrade.String()) == 0 {
-									warnings = append(warnings, taskOp.ErrorSummary)
-								}
-							}
-						}
-						log.Printf("Cluster operation completed for edgename: %s and projectname: %s", edgeName, projectName)
-						break LOOP
-					} else {
-						log.Println("Cluster Provisiong is Complete. Waiting for cluster to be Ready...")
-					}
-				case commonpb.ConditionStatus_StatusFailed:
-					// log.Printf("Cluster operation failed for edgename: %s and projectname: %s with failure reason: %s", edgeName, projectName, uClusterCommonStatus.Reason)
-					failureReasons, err := collectAKSV3UpsertErrors(uCluster.Status)
-					if err != nil {
-						return diag.FromErr(err)
+	err = pollUntil(ctx, pollSpec{
+		Operation:    fmt.Sprintf("AKS cluster %s/%s apply", projectName, edgeName),
+		InitialDelay: 30 * time.Second,
+		MinInterval:  30 * time.Second,
+		MaxInterval:  60 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		uCluster, err := client.Status(ctx, options.StatusOptions{
+			Name:    edgeName,
+			Project: projectName,
+		})
+		if err != nil {
+			log.Printf("Fetching cluster having edgename: %s and projectname: %s failing due to err: %v", edgeName, projectName, err)
+			return false, "", err
+		}
+		if uCluster == nil {
+			return false, "operation not started", nil
+		}
+		if uCluster.Status == nil || uCluster.Status.Aks == nil || uCluster.Status.CommonStatus == nil {
+			return false, "", nil
+		}
+		edgeId := uCluster.Status.Id
+		projectId, err := getProjectIDFromName(projectName)
+		if err != nil {
+			log.Print("error converting project name to id")
+			return false, "", fmt.Errorf("error converting project name to project ID")
+		}
+		uClusterCommonStatus := uCluster.Status.CommonStatus
+		switch uClusterCommonStatus.ConditionStatus {
+		case commonpb.ConditionStatus_StatusOK:
+			log.Println("Checking in cluster conditions for blueprint sync success..")
+			conditionsFailure, clusterReadiness, err := getClusterConditions(edgeId, projectId)
+			if err != nil {
+				log.Printf("error while getCluster %s", err.Error())
+				return false, "", err
+			}
+			if conditionsFailure {
+				log.Printf("blueprint sync failed for edgename: %s and projectname: %s", edgeName, projectName)
+				return false, "", fmt.Errorf("blueprint sync failed for edgename: %s and projectname: %s", edgeName, projectName)
+			}
+			if !clusterReadiness {
+				return false, "provisioned, waiting for cluster to be ready", nil
+			}
+			tasksets := uCluster.Status.LastTasksets
+			if len(tasksets) > 0 {
+				lastTaskset := tasksets[0]
+				for _, taskOp := range lastTaskset.TasksetOperations {
+					if strings.Compare(taskOp.OperationName, edge.ClusterUpgrade.String()) == 0 {
+						warnings = append(warnings, taskOp.ErrorSummary)
 					}
-					return diag.Errorf("Cluster operation failed for edgename: %s and projectname: %s with failure reasons: %s", edgeName, projectName, failureReasons)
 				}
 			}
+			log.Printf("Cluster operation completed for edgename: %s and projectname: %s", edgeName, projectName)
+			return true, "ready", nil
+		case commonpb.ConditionStatus_StatusFailed:
+			failureReasons, err := collectAKSV3UpsertErrors(uCluster.Status)
+			if err != nil {
+				return false, "", err
+			}
+			return false, "", fmt.Errorf("Cluster operation failed for edgename: %s and projectname: %s with failure reasons: %s", edgeName, projectName, failureReasons)
 		}
+		return false, uClusterCommonStatus.ConditionStatus.String(), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	pid, err := getProjectIDFromName(projectName)
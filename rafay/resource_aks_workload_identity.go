@@ -10,11 +10,8 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	schema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +27,9 @@ func resourceAKSWorkloadIdentity() *schema.Resource {
 		ReadContext:   resourceAKSWorkloadIdentityRead,
 		UpdateContext: resourceAKSWorkloadIdentityUpdate,
 		DeleteContext: resourceAKSWorkloadIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAKSWorkloadIdentityImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -42,10 +42,44 @@ func resourceAKSWorkloadIdentity() *schema.Resource {
 	}
 }
 
+// resourceAKSWorkloadIdentityImport imports a workload identity by
+// `name/clustername/project`.
+func resourceAKSWorkloadIdentityImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("invalid id %s, expected name/clustername/project", d.Id())
+	}
+	log.Println("resourceAKSWorkloadIdentityImport idParts:", idParts)
+
+	metadata := []interface{}{
+		map[string]interface{}{
+			"cluster_name": idParts[1],
+			"project":      idParts[2],
+		},
+	}
+	if err := d.Set("metadata", metadata); err != nil {
+		return nil, err
+	}
+	spec := []interface{}{
+		map[string]interface{}{
+			"metadata": []interface{}{
+				map[string]interface{}{
+					"name": idParts[0],
+				},
+			},
+		},
+	}
+	if err := d.Set("spec", spec); err != nil {
+		return nil, err
+	}
+	d.SetId(idParts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceAKSWorkloadIdentityCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("create aks workload identity")
 
-	diags := resourceAKSWorkloadIdentityUpsert(ctx, d)
+	diags := resourceAKSWorkloadIdentityUpsert(ctx, d, m)
 	return diags
 }
 
@@ -68,7 +102,7 @@ func resourceAKSWorkloadIdentityRead(ctx context.Context, d *schema.ResourceData
 	wiClusterName := desiredInfraAksWorkloadIdentity.Metadata.Clustername
 	wiProjectName := desiredInfraAksWorkloadIdentity.Metadata.Project
 
-	deployedAksInfraWorkloadIdentity, err := getAksWorkloadIdentity(ctx, wiName, wiClusterName, wiProjectName)
+	deployedAksInfraWorkloadIdentity, err := getAksWorkloadIdentity(ctx, m, wiName, wiClusterName, wiProjectName)
 	if err != nil {
 		if strings.Contains(err.Error(), "not found") {
 			log.Printf("workload identity %s not found, removing from state", wiName)
@@ -86,14 +120,13 @@ func resourceAKSWorkloadIdentityRead(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
-func getAksWorkloadIdentity(ctx context.Context, name, clusterName, project string) (*infrapb.AksWorkloadIdentity, error) {
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid), options.WithConnectionTimeout(CONN_TIMEOUT))
+func getAksWorkloadIdentity(ctx context.Context, m interface{}, name, clusterName, project string) (*infrapb.AksWorkloadIdentity, error) {
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return nil, err
 	}
 
-	extResponse, err := client.InfraV3().Cluster().ExtApi().GetAksWorkloadIdentity(ctx, options.ExtOptions{
+	extResponse, err := client.ExtApi().GetAksWorkloadIdentity(ctx, options.ExtOptions{
 		Name:    clusterName,
 		Project: project,
 		UrlParams: map[string]string{
@@ -115,14 +148,13 @@ func getAksWorkloadIdentity(ctx context.Context, name, clusterName, project stri
 
 }
 
-func listAksWorkloadIdentity(ctx context.Context, clusterName, project string) (*infrapb.AksWorkloadIdentityList, error) {
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid), options.WithConnectionTimeout(CONN_TIMEOUT))
+func listAksWorkloadIdentity(ctx context.Context, m interface{}, clusterName, project string) (*infrapb.AksWorkloadIdentityList, error) {
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return nil, err
 	}
 
-	extResponse, err := client.InfraV3().Cluster().ExtApi().ListAksWorkloadIdentities(ctx, options.ExtOptions{
+	extResponse, err := client.ExtApi().ListAksWorkloadIdentities(ctx, options.ExtOptions{
 		Name:    clusterName,
 		Project: project,
 	})
@@ -144,7 +176,7 @@ func listAksWorkloadIdentity(ctx context.Context, clusterName, project string) (
 func resourceAKSWorkloadIdentityUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("update aks workload identity")
 
-	return resourceAKSWorkloadIdentityUpsert(ctx, d)
+	return resourceAKSWorkloadIdentityUpsert(ctx, d, m)
 }
 
 func resourceAKSWorkloadIdentityDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -168,13 +200,12 @@ func resourceAKSWorkloadIdentityDelete(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("deleting workload identity: %s for edgename: %s and projectname: %s", wiName, wiClusterName, wiProjectName)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid), options.WithConnectionTimeout(CONN_TIMEOUT))
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	_, err = client.InfraV3().Cluster().ExtApi().DeleteAksWorkloadIdentity(ctx, options.ExtOptions{
+	_, err = client.ExtApi().DeleteAksWorkloadIdentity(ctx, options.ExtOptions{
 		Name:    wiClusterName,
 		Project: wiProjectName,
 		UrlParams: map[string]string{
@@ -196,7 +227,7 @@ LOOP:
 			return diag.FromErr(fmt.Errorf("workload identity deletion %s timed out", wiName))
 
 		case <-ticker.C:
-			aksWorkloadIdentityList, err := listAksWorkloadIdentity(ctx, wiClusterName, wiProjectName)
+			aksWorkloadIdentityList, err := listAksWorkloadIdentity(ctx, m, wiClusterName, wiProjectName)
 			if err != nil {
 				return diag.FromErr(err)
 			}
@@ -216,7 +247,7 @@ LOOP:
 	return diags
 }
 
-func resourceAKSWorkloadIdentityUpsert(ctx context.Context, d *schema.ResourceData) diag.Diagnostics {
+func resourceAKSWorkloadIdentityUpsert(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("upsert aks workload identity")
 
 	var diags diag.Diagnostics
@@ -247,8 +278,7 @@ func resourceAKSWorkloadIdentityUpsert(ctx context.Context, d *schema.ResourceDa
 
 	log.Printf("upserting workload identity: %s for edgename: %s and projectname: %s", wiName, wiClusterName, wiProjectName)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid), options.WithConnectionTimeout(CONN_TIMEOUT))
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -258,7 +288,7 @@ func resourceAKSWorkloadIdentityUpsert(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	response, err := client.InfraV3().Cluster().ExtApi().ApplyAksWorkloadIdentity(ctx, options.ExtOptions{
+	response, err := client.ExtApi().ApplyAksWorkloadIdentity(ctx, options.ExtOptions{
 		Name:    wiClusterName,
 		Project: wiProjectName,
 		Body:    desiredInfraAksWorkloadIdentityBytes,
@@ -291,7 +321,7 @@ LOOP:
 			log.Printf("workload identity %s operation timed out", wiName)
 			return diag.FromErr(fmt.Errorf("workload identity %s operation timed out", wiName))
 		case <-ticker.C:
-			statusCluster, err := client.InfraV3().Cluster().Status(ctx, options.StatusOptions{
+			statusCluster, err := client.Status(ctx, options.StatusOptions{
 				Name:    wiClusterName,
 				Project: wiProjectName,
 			})
@@ -7,13 +7,9 @@ import (
 	"os"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/settingspb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -26,6 +22,9 @@ func resourceAlertConfig() *schema.Resource {
 		ReadContext:   resourceAlertConfigRead,
 		UpdateContext: resourceAlertConfigUpdate,
 		DeleteContext: resourceAlertConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -50,8 +49,7 @@ func resourceAlertConfigCreate(ctx context.Context, d *schema.ResourceData, m in
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -78,8 +76,7 @@ func resourceAlertConfigUpsert(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -111,8 +108,7 @@ func resourceAlertConfigRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
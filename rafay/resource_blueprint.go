@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	v3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
@@ -27,27 +26,22 @@ const (
 	NamespaceScoped = "namespace-scoped"
 )
 
-var getBlueprintClient = func() (v3.BlueprintClient, error) {
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
-	if err != nil {
-		return nil, err
-	}
-	return client.InfraV3().Blueprint(), nil
-}
-
 func blueprintClient(m interface{}) (v3.BlueprintClient, error) {
 	if meta, ok := m.(*ProviderMeta); ok {
 		if meta.BlueprintClientFactory != nil {
 			return meta.BlueprintClientFactory()
 		}
 	}
-	return getBlueprintClient()
+	client, err := getHubClient(m)
+	if err != nil {
+		return nil, err
+	}
+	return client.InfraV3().Blueprint(), nil
 }
 
 func ResourceBluePrint() *schema.Resource {
 	s := copySchemaMap(resource.BlueprintSchema.Schema)
-	return &schema.Resource{
+	return withProjectIdentity(&schema.Resource{
 		CreateContext: resourceBluePrintCreate,
 		ReadContext:   resourceBluePrintRead,
 		UpdateContext: resourceBluePrintUpdate,
@@ -64,7 +58,7 @@ func ResourceBluePrint() *schema.Resource {
 
 		SchemaVersion: 1,
 		Schema:        s,
-	}
+	})
 }
 
 func ResourceBluePrintImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -7,14 +7,10 @@ import (
 	"os"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -74,7 +70,7 @@ func resourceBreakGlassAccessImport(d *schema.ResourceData, meta interface{}) ([
 func resourceBreakGlassAccessCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("break glass access create")
 	var alreadyExists bool
-	alreadyExists = breakGlassAccessExists(ctx, d)
+	alreadyExists = breakGlassAccessExists(ctx, d, m)
 
 	diags := resourceBreakGlassAccessUpsert(ctx, d, m)
 	if diags.HasError() && !alreadyExists {
@@ -86,8 +82,7 @@ func resourceBreakGlassAccessCreate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -102,14 +97,13 @@ func resourceBreakGlassAccessCreate(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
-func breakGlassAccessExists(ctx context.Context, d *schema.ResourceData) bool {
+func breakGlassAccessExists(ctx context.Context, d *schema.ResourceData, m interface{}) bool {
 	bga, err := expandBreakGlassAccess(d)
 	if err != nil {
 		log.Printf("breakGlassAccessExists: breakglassaccess expandBreakGlassAccess error - %s", err.Error())
 		return false
 	}
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return false
 	}
@@ -146,8 +140,7 @@ func resourceBreakGlassAccessUpsert(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -174,8 +167,7 @@ func resourceBreakGlassAccessRead(ctx context.Context, d *schema.ResourceData, m
 		meta.Name = d.State().ID
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -218,8 +210,7 @@ func resourceBreakGlassAccessDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -8,13 +8,9 @@ import (
 	"strings"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/appspb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -27,6 +23,9 @@ func resourceCatalog() *schema.Resource {
 		ReadContext:   resourceCatalogRead,
 		UpdateContext: resourceCatalogUpdate,
 		DeleteContext: resourceCatalogDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -51,8 +50,7 @@ func resourceCatalogCreate(ctx context.Context, d *schema.ResourceData, m interf
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -80,8 +78,7 @@ func resourceCatalogUpsert(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -117,8 +114,7 @@ func resourceCatalogRead(ctx context.Context, d *schema.ResourceData, m interfac
 	// w1 := spew.Sprintf("%+v", tfWorkloadState)
 	// log.Println("resourceWorkloadRead tfWorkloadState", w1)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -179,8 +175,7 @@ func resourceCatalogDelete(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
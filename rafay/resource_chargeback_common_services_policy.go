@@ -8,11 +8,8 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +23,9 @@ func resourceChargebackCommonServicesPolicy() *schema.Resource {
 		ReadContext:   resourceChargebackCommonServicesPolicyRead,
 		UpdateContext: resourceChargebackCommonServicesPolicyUpdate,
 		DeleteContext: resourceChargebackCommonServicesPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -52,8 +52,7 @@ func resourceChargebackCommonServicesPolicyCreate(ctx context.Context, d *schema
 			log.Printf("chargebackCommonServicesPolicy expandChargebackCommonServicesPolicy error")
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -96,8 +95,7 @@ func resourceChargebackCommonServicesPolicyUpsert(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -138,8 +136,7 @@ func resourceChargebackCommonServicesPolicyRead(ctx context.Context, d *schema.R
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -179,8 +176,7 @@ func resourceChargebackCommonServicesPolicyDelete(ctx context.Context, d *schema
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -33,7 +33,7 @@ type clusterOverrideYamlConfig struct {
 }
 
 func resourceClusterOverride() *schema.Resource {
-	return &schema.Resource{
+	return withArtifactDigests(&schema.Resource{
 		CreateContext: resourceClusterOverrideCreate,
 		ReadContext:   resourceClusterOverrideRead,
 		UpdateContext: resourceClusterOverrideUpdate,
@@ -278,7 +278,7 @@ func resourceClusterOverride() *schema.Resource {
 				Type:     schema.TypeList,
 			},
 		},
-	}
+	})
 }
 
 func resourceCluseroverrideImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
@@ -26,6 +27,9 @@ func resourceClusterSharing() *schema.Resource {
 		ReadContext:   resourceClusterSharingRead,
 		UpdateContext: resourceClusterSharingUpdate,
 		DeleteContext: resourceClusterSharingDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceClusterSharingImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -84,6 +88,25 @@ func resourceClusterSharing() *schema.Resource {
 	}
 }
 
+// resourceClusterSharingImport imports the sharing of a cluster by
+// `clustername/project`, project being the project owning the cluster.
+func resourceClusterSharingImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("invalid id %s, expected clustername/project", d.Id())
+	}
+	log.Println("resourceClusterSharingImport idParts:", idParts)
+
+	if err := d.Set("clustername", idParts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("project", idParts[1]); err != nil {
+		return nil, err
+	}
+	d.SetId(idParts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceClusterSharingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return resourceClusterSharingUpsert(ctx, d, true)
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
@@ -33,6 +34,9 @@ func resourceClusterSharingSingle() *schema.Resource {
 		ReadContext:   resourceClusterSharingSingleRead,
 		UpdateContext: resourceClusterSharingSingleUpdate,
 		DeleteContext: resourceClusterSharingSingleDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceClusterSharingSingleImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -91,6 +95,34 @@ func resourceClusterSharingSingle() *schema.Resource {
 	}
 }
 
+// resourceClusterSharingSingleImport imports the sharing of a cluster with one
+// project by `clustername/project/sharedproject`, project being the project
+// owning the cluster.
+func resourceClusterSharingSingleImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 3)
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		return nil, fmt.Errorf("invalid id %s, expected clustername/project/sharedproject", d.Id())
+	}
+	log.Println("resourceClusterSharingSingleImport idParts:", idParts)
+
+	if err := d.Set("clustername", idParts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("project", idParts[1]); err != nil {
+		return nil, err
+	}
+	sharing := []interface{}{
+		map[string]interface{}{
+			"projectname": idParts[2],
+		},
+	}
+	if err := d.Set("sharing", sharing); err != nil {
+		return nil, err
+	}
+	d.SetId(idParts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceClusterSharingSingleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	return resourceClusterSharingSingleUpsert(ctx, d, true)
 }
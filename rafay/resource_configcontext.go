@@ -9,17 +9,15 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	"github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/eaaspb"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceConfigContext() *schema.Resource {
-	return &schema.Resource{
+	return withArtifactDigests(&schema.Resource{
 		CreateContext: resourceConfigContextCreate,
 		ReadContext:   resourceConfigContextRead,
 		UpdateContext: resourceConfigContextUpdate,
@@ -35,8 +33,8 @@ func resourceConfigContext() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema:        resource.ConfigContextSchema.Schema,
-	}
+		Schema:        copySchemaMap(resource.ConfigContextSchema.Schema),
+	})
 }
 
 func resourceConfigContextCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -51,8 +49,7 @@ func resourceConfigContextCreate(ctx context.Context, d *schema.ResourceData, m
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -81,8 +78,7 @@ func resourceConfigContextUpsert(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -112,8 +108,7 @@ func resourceConfigContextRead(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -175,8 +170,7 @@ func resourceConfigContextDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -230,7 +224,11 @@ func expandConfigContextSpec(p []any) (*eaaspb.ConfigContextSpec, error) {
 	}
 
 	if v, ok := in["files"].([]any); ok && len(v) > 0 {
-		spec.Files = expandCommonpbFiles(v)
+		files, err := expandCommonpbFiles(v)
+		if err != nil {
+			return spec, err
+		}
+		spec.Files = files
 	}
 
 	if v, ok := in["variables"].([]any); ok && len(v) > 0 {
@@ -278,25 +276,28 @@ func expandEnvVariables(p []any) []*eaaspb.EnvData {
 	return envvars
 }
 
-func expandConfigContextCompoundRefs(p []any) []*eaaspb.ConfigContextCompoundRef {
+func expandConfigContextCompoundRefs(p []any) ([]*eaaspb.ConfigContextCompoundRef, error) {
 	var ccs []*eaaspb.ConfigContextCompoundRef
 	if len(p) == 0 {
-		return ccs
+		return ccs, nil
 	}
 
 	for i := range p {
 		v, _ := p[i].(map[string]any)
-		cc := expandConfigContextCompoundRef(v)
+		cc, err := expandConfigContextCompoundRef(v)
+		if err != nil {
+			return nil, err
+		}
 		ccs = append(ccs, cc)
 	}
 
-	return ccs
+	return ccs, nil
 }
 
-func expandConfigContextCompoundRef(p map[string]any) *eaaspb.ConfigContextCompoundRef {
+func expandConfigContextCompoundRef(p map[string]any) (*eaaspb.ConfigContextCompoundRef, error) {
 	cc := &eaaspb.ConfigContextCompoundRef{}
 	if len(p) == 0 {
-		return cc
+		return cc, nil
 	}
 
 	if v, ok := p["name"].(string); ok && len(v) > 0 {
@@ -304,16 +305,20 @@ func expandConfigContextCompoundRef(p map[string]any) *eaaspb.ConfigContextCompo
 	}
 
 	if v, ok := p["data"].([]any); ok && len(v) > 0 {
-		cc.Data = expandConfigContextInline(v)
+		data, err := expandConfigContextInline(v)
+		if err != nil {
+			return nil, err
+		}
+		cc.Data = data
 	}
 
-	return cc
+	return cc, nil
 }
 
-func expandConfigContextInline(p []any) *eaaspb.ConfigContextInline {
+func expandConfigContextInline(p []any) (*eaaspb.ConfigContextInline, error) {
 	cc := &eaaspb.ConfigContextInline{}
 	if len(p) == 0 || p[0] == nil {
-		return cc
+		return cc, nil
 	}
 
 	in := p[0].(map[string]any)
@@ -323,14 +328,18 @@ func expandConfigContextInline(p []any) *eaaspb.ConfigContextInline {
 	}
 
 	if v, ok := in["files"].([]any); ok && len(v) > 0 {
-		cc.Files = expandCommonpbFiles(v)
+		files, err := expandCommonpbFiles(v)
+		if err != nil {
+			return nil, err
+		}
+		cc.Files = files
 	}
 
 	if v, ok := in["variables"].([]any); ok && len(v) > 0 {
 		cc.Variables = expandVariables(v)
 	}
 
-	return cc
+	return cc, nil
 }
 
 // Flatteners
@@ -9,11 +9,8 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/integrationspb"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -54,6 +51,9 @@ func resourceContainerRegistry() *schema.Resource {
 		ReadContext:   resourceContainerRegistryRead,
 		UpdateContext: resourceContainerRegistryUpdate,
 		DeleteContext: resourceContainerRegistryDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -82,8 +82,7 @@ func resourceContainerRegistryCreate(ctx context.Context, d *schema.ResourceData
 			log.Printf("ContainerRegistry resourceContainerRegistryCreate error")
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -153,8 +152,7 @@ func resourceContainerRegistryUpsert(ctx context.Context, d *schema.ResourceData
 
 	// }
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -205,8 +203,7 @@ func resourceContainerRegistryRead(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -248,8 +245,7 @@ func resourceContainerRegistryDelete(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -366,7 +362,11 @@ func expandContainerRegistrySpec(p []interface{}, call string) (*integrationspb.
 	}
 
 	if v, ok := in["secret"].([]interface{}); ok {
-		crt.Secret = expandCommonpbFile(v)
+		secret, err := expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
+		crt.Secret = secret
 	}
 
 	if vp, ok := in["credentials"].([]interface{}); ok && len(vp) > 0 {
@@ -10,12 +10,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -67,7 +64,7 @@ type AgentMeta struct {
 }
 
 func resourceCredentials() *schema.Resource {
-	return &schema.Resource{
+	return withProjectIdentity(&schema.Resource{
 		CreateContext: resourceCredentialsCreate,
 		ReadContext:   resourceCredentialsRead,
 		UpdateContext: resourceCredentialsUpdate,
@@ -84,7 +81,7 @@ func resourceCredentials() *schema.Resource {
 
 		SchemaVersion: 1,
 		Schema:        resource.CredentialsSchema.Schema,
-	}
+	})
 }
 
 func resourceCredentialsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -127,13 +124,12 @@ func resourceCredentialsCreate(ctx context.Context, d *schema.ResourceData, m in
 			log.Printf("Credentials expandCredentials error")
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := credentialsClient(ctx, m)
 		if err != nil {
 			return diags
 		}
 
-		err = client.InfraV3().Credentials().Delete(ctx, options.DeleteOptions{
+		err = client.Delete(ctx, options.DeleteOptions{
 			Name:    ss.Metadata.Name,
 			Project: ss.Metadata.Project,
 		})
@@ -172,13 +168,12 @@ func resourceV3CredentialsUpsert(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := credentialsClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.InfraV3().Credentials().Apply(ctx, credentials, options.ApplyOptions{})
+	err = client.Apply(ctx, credentials, options.ApplyOptions{})
 	if err != nil {
 		// XXX Debug
 		n1 := spew.Sprintf("%+v", credentials)
@@ -205,13 +200,12 @@ func resourceCredentialsRead(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := credentialsClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ag, err := client.InfraV3().Credentials().Get(ctx, options.GetOptions{
+	ag, err := client.Get(ctx, options.GetOptions{
 		Name:    tfCredentialsState.Metadata.Name,
 		Project: tfCredentialsState.Metadata.Project,
 	})
@@ -261,12 +255,11 @@ func resourceCredentialsDelete(ctx context.Context, d *schema.ResourceData, m in
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := credentialsClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = client.InfraV3().Credentials().Delete(ctx, options.DeleteOptions{
+	err = client.Delete(ctx, options.DeleteOptions{
 		Name:    cred.Metadata.Name,
 		Project: cred.Metadata.Project,
 	})
@@ -7,14 +7,10 @@ import (
 	"os"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -73,7 +69,7 @@ func resourceCustomRoleImport(d *schema.ResourceData, meta interface{}) ([]*sche
 
 func resourceCustomRoleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("custom role create")
-	create := isCustomRoleAlreadyExists(ctx, d)
+	create := isCustomRoleAlreadyExists(ctx, d, m)
 	diags := resourceCustomRoleUpsert(ctx, d, m)
 	if diags.HasError() && !create {
 		tflog := os.Getenv("TF_LOG")
@@ -84,8 +80,7 @@ func resourceCustomRoleCreate(ctx context.Context, d *schema.ResourceData, m int
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -122,8 +117,7 @@ func resourceCustomRoleUpsert(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -150,8 +144,7 @@ func resourceCustomRoleRead(ctx context.Context, d *schema.ResourceData, m inter
 		meta.Name = d.State().ID
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -194,8 +187,7 @@ func resourceCustomRoleDelete(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -379,14 +371,13 @@ func flattenPolicyList(input []*systempb.PolicyRef, p []interface{}) []interface
 	return out
 }
 
-func isCustomRoleAlreadyExists(ctx context.Context, d *schema.ResourceData) bool {
+func isCustomRoleAlreadyExists(ctx context.Context, d *schema.ResourceData, m interface{}) bool {
 	meta := GetMetaData(d)
 	if meta == nil {
 		return false
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return false
 	}
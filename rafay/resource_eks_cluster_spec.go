@@ -47,6 +47,9 @@ func resourceEKSClusterSpec() *schema.Resource {
 		ReadContext:   resourceEKSClusterSpecRead,
 		UpdateContext: resourceEKSClusterSpecUpdate,
 		DeleteContext: resourceEKSClusterSpecDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEKSClusterSpecImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -157,6 +160,10 @@ func collateConfigsByNameSpec(rafayConfigs, clusterConfigs [][]byte) (map[string
 	return result, errs
 }
 
+func resourceEKSClusterSpecImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return importClusterByName(d, "name", "projectname")
+}
+
 func resourceEKSClusterSpecCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
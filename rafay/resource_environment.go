@@ -9,17 +9,15 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/eaaspb"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceEnvironment() *schema.Resource {
-	return &schema.Resource{
+	return withArtifactDigests(&schema.Resource{
 		CreateContext: resourceEnvironmentCreate,
 		ReadContext:   resourceEnvironmentRead,
 		UpdateContext: resourceEnvironmentUpdate,
@@ -35,8 +33,8 @@ func resourceEnvironment() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema:        resource.EnvironmentSchema.Schema,
-	}
+		Schema:        copySchemaMap(resource.EnvironmentSchema.Schema),
+	})
 }
 
 func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m any) diag.Diagnostics {
@@ -51,13 +49,12 @@ func resourceEnvironmentCreate(ctx context.Context, d *schema.ResourceData, m an
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := environmentClient(ctx, m)
 		if err != nil {
 			return diags
 		}
 
-		err = client.EaasV1().Environment().Delete(ctx, options.DeleteOptions{
+		err = client.Delete(ctx, options.DeleteOptions{
 			Name:    environment.Metadata.Name,
 			Project: environment.Metadata.Project,
 		})
@@ -81,51 +78,52 @@ func environmentUpsert(ctx context.Context, d *schema.ResourceData, m any) diag.
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := environmentClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.EaasV1().Environment().Apply(ctx, environment, options.ApplyOptions{})
+	err = client.Apply(ctx, environment, options.ApplyOptions{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
 	// wait for publish
-	for {
-		select {
-		case <-ticker.C:
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("context cancelled"))
-		}
-		envs, err := client.EaasV1().Environment().Status(ctx, options.StatusOptions{
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	err = pollUntil(ctx, pollSpec{
+		Operation:   "environment publish",
+		Timeout:     timeout,
+		MinInterval: 10 * time.Second,
+		MaxInterval: 60 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		envs, err := client.Status(ctx, options.StatusOptions{
 			Name:    environment.Metadata.Name,
 			Project: environment.Metadata.Project,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return false, "", err
 		}
-		if envs.GetStatus() != nil {
-			//check if environment provisioning, if true break out of loop
-			if status := envs.GetStatus().GetDigestedStatus().GetConditionStatus(); status == commonpb.ConditionStatus_StatusOK ||
-				status == commonpb.ConditionStatus_StatusNotSet {
-				break
-			}
-			if envs.GetStatus().GetDigestedStatus().GetConditionStatus() == commonpb.ConditionStatus_StatusFailed {
-				return diag.FromErr(fmt.Errorf("%s %s", "failed to publish environment", envs.GetStatus().GetDigestedStatus().GetReason()))
-			}
-			if envs.GetStatus().GetDigestedStatus().GetConditionStatus() == commonpb.ConditionStatus_StatusSubmitted {
-				if strings.Contains(envs.GetStatus().GetDigestedStatus().GetReason(), "trigger not processed") {
-					return diag.FromErr(fmt.Errorf("%s %s", "failed to publish environment", envs.GetStatus().GetLatestEvents()[0].GetTriggerDetails().GetReason()))
-				}
+		if envs.GetStatus() == nil {
+			return true, "", nil
+		}
+		digested := envs.GetStatus().GetDigestedStatus()
+		switch digested.GetConditionStatus() {
+		case commonpb.ConditionStatus_StatusOK, commonpb.ConditionStatus_StatusNotSet:
+			return true, digested.GetConditionStatus().String(), nil
+		case commonpb.ConditionStatus_StatusFailed:
+			return false, "", fmt.Errorf("%s %s", "failed to publish environment", digested.GetReason())
+		case commonpb.ConditionStatus_StatusSubmitted:
+			if strings.Contains(digested.GetReason(), "trigger not processed") {
+				return false, "", fmt.Errorf("%s %s", "failed to publish environment", envs.GetStatus().GetLatestEvents()[0].GetTriggerDetails().GetReason())
 			}
-		} else {
-			break
 		}
-
+		return false, fmt.Sprintf("%s %s", digested.GetConditionStatus(), digested.GetReason()), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(environment.Metadata.Name)
@@ -148,14 +146,13 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := environmentClient(ctx, m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
 	}
 
-	environment, err := client.EaasV1().Environment().Get(ctx, options.GetOptions{
+	environment, err := client.Get(ctx, options.GetOptions{
 		Name:    meta.Name,
 		Project: et.Metadata.Project,
 	})
@@ -211,13 +208,12 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := environmentClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.EaasV1().Environment().Delete(ctx, options.DeleteOptions{
+	err = client.Delete(ctx, options.DeleteOptions{
 		Name:    env.Metadata.Name,
 		Project: env.Metadata.Project,
 	})
@@ -226,42 +222,38 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, m an
 	}
 
 	// wait for destroy
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-	// wait for publish
-	for {
-		select {
-		case <-ticker.C:
-		case <-ctx.Done():
-			return diag.FromErr(fmt.Errorf("context cancelled"))
-		}
-		envs, err := client.EaasV1().Environment().Status(ctx, options.StatusOptions{
+	err = pollUntil(ctx, pollSpec{
+		Operation:   "environment destroy",
+		Timeout:     d.Timeout(schema.TimeoutDelete),
+		MinInterval: 10 * time.Second,
+		MaxInterval: 60 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		envs, err := client.Status(ctx, options.StatusOptions{
 			Name:    env.Metadata.Name,
 			Project: env.Metadata.Project,
 		})
 		if err != nil {
 			if strings.Contains(err.Error(), "not found") {
 				log.Printf("environment %s deleted successfully", env.Metadata.Name)
-				return diags
+				return true, "deleted", nil
 			}
-
-			log.Printf("environment %s deletion failed with error: %s", env.Metadata.Name, err.Error())
-			return diag.FromErr(err)
+			return false, "", err
 		}
-
-		if envs.GetStatus() != nil {
-			//check if environment provisioning, if true break out of loop
-			if status := envs.GetStatus().GetDigestedStatus().GetConditionStatus(); status == commonpb.ConditionStatus_StatusOK ||
-				status == commonpb.ConditionStatus_StatusNotSet {
-				break
-			}
-			if envs.GetStatus().GetDigestedStatus().GetConditionStatus() == commonpb.ConditionStatus_StatusFailed {
-				return diag.FromErr(fmt.Errorf("%s %s", "failed to destroy environment", envs.GetStatus().GetDigestedStatus().GetReason()))
-			}
-		} else {
-			break
+		if envs.GetStatus() == nil {
+			return true, "", nil
 		}
-
+		digested := envs.GetStatus().GetDigestedStatus()
+		switch digested.GetConditionStatus() {
+		case commonpb.ConditionStatus_StatusOK, commonpb.ConditionStatus_StatusNotSet:
+			return true, digested.GetConditionStatus().String(), nil
+		case commonpb.ConditionStatus_StatusFailed:
+			return false, "", fmt.Errorf("%s %s", "failed to destroy environment", digested.GetReason())
+		}
+		return false, fmt.Sprintf("%s %s", digested.GetConditionStatus(), digested.GetReason()), nil
+	})
+	if err != nil {
+		log.Printf("environment %s deletion failed with error: %s", env.Metadata.Name, err.Error())
+		return diag.FromErr(err)
 	}
 
 	return diags
@@ -329,7 +321,11 @@ func expandEnvironmentSpec(p []any) (*eaaspb.EnvironmentSpec, error) {
 	}
 
 	if f, ok := in["files"].([]any); ok && len(f) > 0 {
-		spec.Files = expandCommonpbFiles(f)
+		files, err := expandCommonpbFiles(f)
+		if err != nil {
+			return nil, err
+		}
+		spec.Files = files
 	}
 
 	if so, ok := in["schedule_optouts"].([]any); ok && len(so) > 0 {
@@ -10,11 +10,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/eaaspb"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -59,8 +57,7 @@ func environmentTemplateUpsert(ctx context.Context, d *schema.ResourceData, m an
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -90,8 +87,7 @@ func resourceEnvironmentTemplateRead(ctx context.Context, d *schema.ResourceData
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -153,8 +149,7 @@ func resourceEnvironmentTemplateDelete(ctx context.Context, d *schema.ResourceDa
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -251,7 +246,10 @@ func expandEnvironmentTemplateSpec(p []any) (*eaaspb.EnvironmentTemplateSpec, er
 	}
 
 	if v, ok := in["contexts"].([]any); ok && len(v) > 0 {
-		spec.Contexts = expandContexts(v)
+		spec.Contexts, err = expandContexts(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := in["agent_override"].([]any); ok && len(v) > 0 {
@@ -311,7 +309,10 @@ func expandSchedules(p []any) ([]*eaaspb.Schedules, error) {
 		}
 
 		if v, ok := in["context"].([]any); ok && len(v) > 0 && v[0] != nil {
-			schd.Context = expandConfigContextCompoundRef(v[0].(map[string]any))
+			schd.Context, err = expandConfigContextCompoundRef(v[0].(map[string]any))
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if v, ok := in["opt_out_options"].([]any); ok && len(v) > 0 {
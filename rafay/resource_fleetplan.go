@@ -10,12 +10,10 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb/timestamppb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
 	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -33,6 +31,9 @@ func resourceFleetPlan() *schema.Resource {
 		UpdateContext: resourceFleetPlanUpdate,
 		ReadContext:   resourceFleetPlanRead,
 		DeleteContext: resourceFleetPlanDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Update: schema.DefaultTimeout(10 * time.Minute),
@@ -44,14 +45,14 @@ func resourceFleetPlan() *schema.Resource {
 }
 
 func resourceFleetPlanCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return resourceFleetPlanUpsert(context.Background(), d)
+	return resourceFleetPlanUpsert(context.Background(), d, m)
 }
 
 func resourceFleetPlanUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	return resourceFleetPlanUpsert(context.Background(), d)
+	return resourceFleetPlanUpsert(context.Background(), d, m)
 }
 
-func resourceFleetPlanUpsert(ctx context.Context, d *schema.ResourceData) diag.Diagnostics {
+func resourceFleetPlanUpsert(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	log.Printf("fleetplan upsert starts")
 	tflog := os.Getenv("TF_LOG")
@@ -74,8 +75,7 @@ func resourceFleetPlanUpsert(ctx context.Context, d *schema.ResourceData) diag.D
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT)
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -103,8 +103,7 @@ func resourceFleetPlanUpsert(ctx context.Context, d *schema.ResourceData) diag.D
 func resourceFleetPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent())
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -161,8 +160,7 @@ func resourceFleetPlanDelete(ctx context.Context, d *schema.ResourceData, m inte
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -6,13 +6,11 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -23,6 +21,9 @@ func resourceFleetPlanTrigger() *schema.Resource {
 		ReadContext:   readFleetPlanJob,
 		UpdateContext: updateFleetPlanJob,
 		DeleteContext: deleteFleetPlanJob,
+		Importer: &schema.ResourceImporter{
+			StateContext: importFleetPlanJob,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Hour),   // 2 hours
 			Update: schema.DefaultTimeout(2 * time.Hour),   // 2 hour
@@ -48,17 +49,58 @@ func resourceFleetPlanTrigger() *schema.Resource {
 	}
 }
 
+// importFleetPlanJob imports the latest job of a fleet plan by
+// `fleetplan_name/project`.
+func importFleetPlanJob(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("invalid id %s, expected fleetplan_name/project", d.Id())
+	}
+	log.Println("importFleetPlanJob idParts:", idParts)
+
+	client, err := getHubClient(m)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := client.InfraV3().FleetPlan().ExtApi().GetJobs(ctx, options.ExtOptions{
+		Name:    idParts[0],
+		Project: idParts[1],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	jobList := &infrapb.FleetPlanJobList{}
+	err = json.Unmarshal(response.Body, jobList)
+	if err != nil {
+		return nil, err
+	}
+	if len(jobList.Items) == 0 || jobList.Items[0].Metadata == nil {
+		return nil, fmt.Errorf("fleet plan %s in project %s has no jobs", idParts[0], idParts[1])
+	}
+
+	if err := d.Set("fleetplan_name", idParts[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("project", idParts[1]); err != nil {
+		return nil, err
+	}
+	d.SetId(jobList.Items[0].Metadata.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func createFleetPlanJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("Creating FleetPlan job")
-	return upsertFleetPlanJob(ctx, d)
+	return upsertFleetPlanJob(ctx, d, m)
 }
 
 func updateFleetPlanJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("Updating FleetPlan job")
-	return upsertFleetPlanJob(ctx, d)
+	return upsertFleetPlanJob(ctx, d, m)
 }
 
-func upsertFleetPlanJob(ctx context.Context, d *schema.ResourceData) diag.Diagnostics {
+func upsertFleetPlanJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("fleetplan job upsert starts..")
 	var diags diag.Diagnostics
 	// Implement the logic to upsert a fleet plan job
@@ -72,8 +114,7 @@ func upsertFleetPlanJob(ctx context.Context, d *schema.ResourceData) diag.Diagno
 
 	log.Printf("upserting fleetplan job for fleetplan: %s, project: %s", fleetPlanName, fleetPlanProject)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid), options.WithConnectionTimeout(CONN_TIMEOUT))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -188,8 +229,7 @@ func readFleetPlanJob(ctx context.Context, d *schema.ResourceData, m interface{}
 	fleetPlanProject := d.Get("project").(string)
 
 	// Implement the logic to read a fleet plan job
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid), options.WithConnectionTimeout(CONN_TIMEOUT))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -12,12 +12,9 @@ import (
 
 	dynamic "github.com/RafaySystems/rafay-common/pkg/hub/client/dynamic"
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
 	"github.com/RafaySystems/rctl/pkg/cluster"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 	_tflog "github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -101,14 +98,13 @@ func resourceGKEClusterV3Upsert(ctx context.Context, d *schema.ResourceData, m i
 
 	log.Println(">>>>>> CLUSTER: ", c)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent())
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Println("GKE Cluster upsert: Invoking V3 Cluster Apply")
-	err = client.InfraV3().Cluster().Apply(ctx, c, options.ApplyOptions{})
+	err = client.Apply(ctx, c, options.ApplyOptions{})
 	if err != nil {
 		// XXX Debug
 		n1 := spew.Sprintf("%+v", c)
@@ -116,51 +112,54 @@ func resourceGKEClusterV3Upsert(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	// wait for cluster creation
-	ticker := time.NewTicker(time.Duration(60) * time.Second)
-	defer ticker.Stop()
-	timeout := time.After(time.Duration(90) * time.Minute)
-
 	cName := c.Metadata.Name
 	pName := c.Metadata.Project
 	d.SetId(c.Metadata.Name)
 
-LOOP:
-	for {
-		select {
-		case <-timeout:
-			log.Printf("Cluster operation timed out for clusterName: %s and projectname: %s", cName, pName)
-			return diag.FromErr(fmt.Errorf("cluster operation timed out for clusterName: %s and projectname: %s", cName, pName))
-		case <-ticker.C:
-			uCluster, err2 := client.InfraV3().Cluster().Status(ctx, options.StatusOptions{
-				Name:    cName,
-				Project: pName,
-			})
-			if err2 != nil {
-				log.Printf("Unable to fetch cluster: %s with projectname: %s . failing due to err: %v", cName, pName, err2)
-				return diag.FromErr(err2)
-			} else if uCluster == nil {
-				log.Printf("Cluster operation has not started. cluster: %s and projectname: %s", cName, pName)
-			} else if uCluster.Status != nil && uCluster.Status.Gke != nil {
-				gkeStatus := uCluster.Status.Gke
-				uClusterCommonStatus := uCluster.Status.CommonStatus
-				switch uClusterCommonStatus.ConditionStatus {
-				case commonpb.ConditionStatus_StatusSubmitted:
-					log.Printf("Cluster operation not completed for cluster: %s and projectname: %s. Waiting 60 seconds more for the operation to complete.", cName, pName)
-				case commonpb.ConditionStatus_StatusOK:
-					log.Printf("Cluster operation completed for cluster: %s and projectname: %s", cName, pName)
-					break LOOP
-				case commonpb.ConditionStatus_StatusFailed:
-					failureReasons, err := collectGKEUpsertErrors(gkeStatus)
-					if err != nil {
-						return diag.Errorf("Cluster operation failed for cluster: %s and projectname: %s. Error collecting reasons: %s", cName, pName, err)
-					}
-					log.Printf("Cluster operation failed for cluster: %s and projectname: %s with failure reason: %s", cName, pName, uClusterCommonStatus.Reason)
-					return diag.Errorf("Cluster operation failed for cluster: %s and projectname: %s with failure reasons: %s", cName, pName, failureReasons)
-				}
-
+	// wait for cluster creation
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	err = pollUntil(ctx, pollSpec{
+		Operation:    fmt.Sprintf("GKE cluster %s/%s apply", pName, cName),
+		Timeout:      timeout,
+		InitialDelay: 30 * time.Second,
+		MinInterval:  30 * time.Second,
+		MaxInterval:  60 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		uCluster, err := client.Status(ctx, options.StatusOptions{
+			Name:    cName,
+			Project: pName,
+		})
+		if err != nil {
+			log.Printf("Unable to fetch cluster: %s with projectname: %s . failing due to err: %v", cName, pName, err)
+			return false, "", err
+		}
+		if uCluster == nil {
+			return false, "operation not started", nil
+		}
+		if uCluster.Status == nil || uCluster.Status.Gke == nil {
+			return false, "", nil
+		}
+		gkeStatus := uCluster.Status.Gke
+		uClusterCommonStatus := uCluster.Status.CommonStatus
+		switch uClusterCommonStatus.ConditionStatus {
+		case commonpb.ConditionStatus_StatusOK:
+			log.Printf("Cluster operation completed for cluster: %s and projectname: %s", cName, pName)
+			return true, uClusterCommonStatus.ConditionStatus.String(), nil
+		case commonpb.ConditionStatus_StatusFailed:
+			failureReasons, err := collectGKEUpsertErrors(gkeStatus)
+			if err != nil {
+				return false, "", fmt.Errorf("Cluster operation failed for cluster: %s and projectname: %s. Error collecting reasons: %s", cName, pName, err)
 			}
+			log.Printf("Cluster operation failed for cluster: %s and projectname: %s with failure reason: %s", cName, pName, uClusterCommonStatus.Reason)
+			return false, "", fmt.Errorf("Cluster operation failed for cluster: %s and projectname: %s with failure reasons: %s", cName, pName, failureReasons)
 		}
+		return false, uClusterCommonStatus.ConditionStatus.String(), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	pid, err := getProjectIDFromName(c.Metadata.Project)
@@ -223,13 +222,12 @@ func resourceGKEClusterV3Read(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent())
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	ag, err := client.InfraV3().Cluster().Get(ctx, options.GetOptions{
+	ag, err := client.Get(ctx, options.GetOptions{
 		Name:    tfClusterState.Metadata.Name,
 		Project: tfClusterState.Metadata.Project,
 	})
@@ -319,14 +317,13 @@ func resourceGKEClusterV3Delete(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := clusterClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	log.Printf("GKE Cluster delete: Invoking V3 Cluster Delete")
-	err = client.InfraV3().Cluster().Delete(ctx, options.DeleteOptions{
+	err = client.Delete(ctx, options.DeleteOptions{
 		Name:    ag.Metadata.Name,
 		Project: ag.Metadata.Project,
 	})
@@ -335,36 +332,32 @@ func resourceGKEClusterV3Delete(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	ticker := time.NewTicker(time.Duration(30) * time.Second)
-	defer ticker.Stop()
-	timeout := time.After(time.Duration(10) * time.Minute)
-
 	edgeName := ag.Metadata.Name
 	projectName := ag.Metadata.Project
 
-LOOP:
-	for {
-		select {
-		case <-timeout:
-			log.Printf("Cluster Deletion for cluster: %s and projectname: %s got timeout out.", edgeName, projectName)
-			return diag.FromErr(fmt.Errorf("cluster deletion for cluster: %s and projectname: %s got timeout out", edgeName, projectName))
-		case <-ticker.C:
-			_, err := client.InfraV3().Cluster().Get(ctx, options.GetOptions{
-				Name:    edgeName,
-				Project: projectName,
-			})
-			if dErr, ok := err.(*dynamic.DynamicClientGetError); ok && dErr != nil {
-				switch dErr.StatusCode {
-				case http.StatusNotFound:
-					log.Printf("Cluster Deletion completes for cluster: %s and projectname: %s", edgeName, projectName)
-					break LOOP
-				default:
-					log.Printf("Cluster Deletion failed for cluster: %s and projectname: %s with error: %s", edgeName, projectName, dErr.Error())
-					return diag.FromErr(dErr)
-				}
+	err = pollUntil(ctx, pollSpec{
+		Operation:    fmt.Sprintf("GKE cluster %s/%s deletion", projectName, edgeName),
+		Timeout:      d.Timeout(schema.TimeoutDelete),
+		InitialDelay: 30 * time.Second,
+		MinInterval:  30 * time.Second,
+		MaxInterval:  30 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		_, err := client.Get(ctx, options.GetOptions{
+			Name:    edgeName,
+			Project: projectName,
+		})
+		if dErr, ok := err.(*dynamic.DynamicClientGetError); ok && dErr != nil {
+			if dErr.StatusCode == http.StatusNotFound {
+				log.Printf("Cluster Deletion completes for cluster: %s and projectname: %s", edgeName, projectName)
+				return true, "deleted", nil
 			}
-			log.Printf("Cluster Deletion is in progress for cluster: %s and projectname: %s", edgeName, projectName)
+			log.Printf("Cluster Deletion failed for cluster: %s and projectname: %s with error: %s", edgeName, projectName, dErr.Error())
+			return false, "", dErr
 		}
+		return false, "deletion in progress", nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	return diags
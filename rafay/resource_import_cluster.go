@@ -1,14 +1,12 @@
 package rafay
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"log"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"reflect"
 	"strings"
@@ -39,6 +37,9 @@ func resourceImportCluster() *schema.Resource {
 		ReadContext:   resourceImportClusterRead,
 		UpdateContext: resourceImportClusterUpdate,
 		DeleteContext: resourceImportClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceImportClusterImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -63,6 +64,7 @@ func resourceImportCluster() *schema.Resource {
 			"blueprint_version": {
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 			},
 			"location": {
 				Type:     schema.TypeString,
@@ -72,6 +74,8 @@ func resourceImportCluster() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"kubernetes":        importClusterKubernetesSchema(),
+			"bootstrap_timeout": importClusterBootstrapTimeoutSchema(),
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -96,13 +100,21 @@ func resourceImportCluster() *schema.Resource {
 					return old == new
 				},
 			},
+			"write_files": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Write the bootstrap and values files to bootstrap_path and values_path. When false they are only kept in bootstrap_data and values_data, e.g. for read-only runners",
+			},
 			"values_data": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"bootstrap_data": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 			"labels": {
 				Type:     schema.TypeMap,
@@ -292,6 +304,10 @@ func GetValuesFile(name, project string) (string, error) {
 	return string(b), nil
 }
 
+func resourceImportClusterImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	return importClusterByName(d, "clustername", "projectname")
+}
+
 func resourceImportClusterCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	var bootstrap_path, values_path string
@@ -330,9 +346,23 @@ func resourceImportClusterCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	time.Sleep(10 * time.Second)
-	//if error with get cluster add a sleep to wait for cluster creation
-	//make sure new imported cluster was created by calling get cluster and checking for no errors
+	//make sure new imported cluster was created by polling get cluster until it succeeds
+	err = pollUntil(ctx, pollSpec{
+		Operation:    "imported cluster registration",
+		Timeout:      2 * time.Minute,
+		InitialDelay: 5 * time.Second,
+		MinInterval:  5 * time.Second,
+		MaxInterval:  20 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		if _, err := cluster.GetCluster(d.Get("clustername").(string), project_id, ""); err != nil {
+			return false, err.Error(), nil
+		}
+		return true, "registered", nil
+	})
+	if err != nil {
+		log.Printf("imported cluster was not created, error %s", err.Error())
+		return diag.FromErr(err)
+	}
 	cluster_resp, err := cluster.GetCluster(d.Get("clustername").(string), project_id, "")
 	if err != nil {
 		log.Printf("imported cluster was not created, error %s", err.Error())
@@ -367,30 +397,6 @@ func resourceImportClusterCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	log.Println("values_filepath fetched correctly: \n", values_file)
-	//write values file into values file path
-	if (d.Get("values_path").(string)) != "" {
-		log.Printf("Saving values file to: %s", d.Get("values_path").(string))
-		values_path = d.Get("values_path").(string)
-	} else {
-		values_filename := d.Get("clustername").(string) + "-values.yaml"
-		values_path, _ = filepath.Abs(values_filename)
-		log.Printf("Saving values file to: %s", values_path)
-		d.Set("values_path", values_path)
-	}
-	fv, err := os.Create(values_path)
-
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer fv.Close()
-
-	_, err2 := fv.WriteString(values_file)
-
-	if err2 != nil {
-		log.Printf("values yaml file was not written correctly, error %s", err2.Error())
-		return diag.FromErr(err2)
-	}
 	d.Set("values_data", values_file)
 
 	//then retrieve bootstrap yaml file, call GetBootstrapFile() -> make sure this function downloads the bootstrap file locally (i think the url request does)
@@ -400,46 +406,51 @@ func resourceImportClusterCreate(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 	log.Println("bootstrap_filepath got correctly: \n", bootstrap_file)
-	//write bootstrap file into bootstrap file path
-	if (d.Get("bootstrap_path").(string)) != "" {
-		log.Printf("Saving bootstrap file to: %s", d.Get("bootstrap_path").(string))
+	d.Set("bootstrap_data", bootstrap_file)
+
+	if d.Get("write_files").(bool) {
+		//write values file into values file path
+		values_path = d.Get("values_path").(string)
+		if values_path == "" {
+			values_path, _ = filepath.Abs(d.Get("clustername").(string) + "-values.yaml")
+			d.Set("values_path", values_path)
+		}
+		log.Printf("Saving values file to: %s", values_path)
+		if err := writeImportClusterFile(values_path, values_file); err != nil {
+			return diag.FromErr(err)
+		}
+
+		//write bootstrap file into bootstrap file path
 		bootstrap_path = d.Get("bootstrap_path").(string)
-	} else {
-		bootstrap_path, _ = filepath.Abs("bootstrap.yaml")
+		if bootstrap_path == "" {
+			bootstrap_path, _ = filepath.Abs("bootstrap.yaml")
+			d.Set("bootstrap_path", bootstrap_path)
+		}
 		log.Printf("Saving bootstrap file to: %s", bootstrap_path)
-		d.Set("bootstrap_path", bootstrap_path)
+		if err := writeImportClusterFile(bootstrap_path, bootstrap_file); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.Get("values_path").(string) != "" || d.Get("bootstrap_path").(string) != "" {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Bootstrap and values files not written",
+			Detail:   "write_files is false, so bootstrap_path and values_path are ignored. Use bootstrap_data and values_data instead.",
+		})
 	}
-	f, err := os.Create(bootstrap_path)
 
+	//apply bootstrap yaml to the cluster when a connection to it is given
+	restConfig, err := importClusterRESTConfig(d)
 	if err != nil {
-		log.Fatal(err)
-	}
-
-	defer f.Close()
-
-	_, err2 = f.WriteString(bootstrap_file)
-
-	if err2 != nil {
-		log.Printf("bootstrap yaml file was not written correctly, error %s", err2.Error())
-		return diag.FromErr(err2)
+		return diag.FromErr(err)
 	}
-	d.Set("bootstrap_data", bootstrap_file)
-	//pass in bootstrap file path into exec command
-	// bootstrap_filepath, _ := filepath.Abs("bootstrap.yaml")
-	//figure out how to apply bootstrap yaml file to created cluster STILL NEED TO COMPLETE
-	//add kube_config file as optional schema, call os/exec to cal kubectl apply on the filepath to kube config
-	time.Sleep(60 * time.Second)
-	if (d.Get("kubeconfig_path").(string)) != "" {
-		cmd := exec.Command("kubectl", "--kubeconfig", d.Get("kubeconfig_path").(string), "apply", "-f", bootstrap_path)
-		var out bytes.Buffer
-
-		//cmd.Stdout = &out
-		log.Println("load client", "id", project_id, "command", cmd)
-		b, err := cmd.CombinedOutput()
-		if err != nil {
-			fmt.Print("failed to apply bootstrap yaml to cluster")
-			log.Println("kubectl command failed to apply bootstrap yaml file", string(b))
-			log.Println("command", "id", project_id, "error", err, "out", out.String())
+	if restConfig != nil {
+		timeout := importClusterBootstrapTimeout(d)
+		if err := applyImportClusterBootstrap(ctx, restConfig, bootstrap_file, d.Get("clustername").(string), project_id, timeout); err != nil {
+			return append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Cluster bootstrap failed",
+				Detail:   fmt.Sprintf("The cluster was registered but the bootstrap did not take: %s", err),
+			})
 		}
 	}
 
@@ -447,6 +458,16 @@ func resourceImportClusterCreate(ctx context.Context, d *schema.ResourceData, m
 
 }
 
+// writeImportClusterFile writes a bootstrap or values file of an imported
+// cluster. The bootstrap carries cluster credentials, so the file is only
+// readable by its owner.
+func writeImportClusterFile(path, content string) error {
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		return fmt.Errorf("unable to write %s: %w", path, err)
+	}
+	return nil
+}
+
 func resourceImportClusterRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	resp, err := project.GetProjectByName(d.Get("projectname").(string))
@@ -474,6 +495,28 @@ func resourceImportClusterRead(ctx context.Context, d *schema.ResourceData, m in
 		log.Printf("get group set name error %s", err.Error())
 		return diag.FromErr(err)
 	}
+	if err := d.Set("blueprint", c.ClusterBlueprint); err != nil {
+		log.Printf("set blueprint error %s", err.Error())
+		return diag.FromErr(err)
+	}
+	if err := d.Set("blueprint_version", c.ClusterBlueprintVersion); err != nil {
+		log.Printf("set blueprint_version error %s", err.Error())
+		return diag.FromErr(err)
+	}
+	// clusters registered before these were recorded report them empty;
+	// keep the configured values, which default to what the console assumed
+	if v := c.ClusterProvisionParams.KubernetesProvider; v != "" {
+		if err := d.Set("kubernetes_provider", v); err != nil {
+			log.Printf("set kubernetes_provider error %s", err.Error())
+			return diag.FromErr(err)
+		}
+	}
+	if v := c.ClusterProvisionParams.ProvisionEnvironment; v != "" {
+		if err := d.Set("provision_environment", v); err != nil {
+			log.Printf("set provision_environment error %s", err.Error())
+			return diag.FromErr(err)
+		}
+	}
 
 	labels, err := getClusterlabels(c.Name, c.ProjectID)
 	if err != nil {
@@ -600,5 +643,24 @@ func resourceImportClusterDelete(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
+	//remove the operator when the provider applied the bootstrap
+	restConfig, err := importClusterRESTConfig(d)
+	if err != nil {
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Rafay operator not removed",
+			Detail:   err.Error(),
+		})
+	}
+	if bootstrap, _ := d.Get("bootstrap_data").(string); restConfig != nil && bootstrap != "" {
+		if err := deleteImportClusterBootstrap(ctx, restConfig, bootstrap); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Rafay operator not removed",
+				Detail:   fmt.Sprintf("The cluster was deleted from the console but its bootstrap could not be removed: %s", err),
+			})
+		}
+	}
+
 	return diags
 }
@@ -10,13 +10,11 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/gitopspb"
 	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/RafaySystems/rctl/pkg/infraprovisioner"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -46,6 +44,9 @@ func resourceInfraProvisioner() *schema.Resource {
 		ReadContext:   resourceInfraProvisionerRead,
 		UpdateContext: resourceInfraProvisionerUpdate,
 		DeleteContext: resourceInfraProvisionerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -72,8 +73,7 @@ func resourceInfraProvisionerCreate(ctx context.Context, d *schema.ResourceData,
 			log.Printf("infra provisioner expandInfraProvisioner error")
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -117,8 +117,7 @@ func resourceInfraProvisionerUpsert(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -150,8 +149,7 @@ func resourceInfraProvisionerRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -197,8 +195,7 @@ func resourceInfraProvisionerDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -282,11 +279,19 @@ func expandInfraProvisionerSpec(p []interface{}) (*gitopspb.InfraProvisionerSpec
 	}
 
 	if v, ok := in["folderPath"].([]interface{}); ok {
-		ipSpec.FolderPath = expandCommonpbFile(v)
+		folderPath, err := expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
+		ipSpec.FolderPath = folderPath
 	}
 
 	if v, ok := in["secret"].([]interface{}); ok {
-		ipSpec.Secret = expandCommonpbFile(v)
+		secret, err := expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
+		ipSpec.Secret = secret
 	}
 
 	if vp, ok := in["config"].([]interface{}); ok && len(vp) > 0 {
@@ -303,7 +308,11 @@ func expandInfraProvisionerSpec(p []interface{}) (*gitopspb.InfraProvisionerSpec
 		}
 
 		if v, ok := inp["tf_vars_file_path"].([]interface{}); ok {
-			ipSpec.Config.TfVarsFilePath = expandCommonpbFile(v)
+			tfVarsFilePath, err := expandCommonpbFile(v)
+			if err != nil {
+				return nil, err
+			}
+			ipSpec.Config.TfVarsFilePath = tfVarsFilePath
 		}
 
 		if v, ok := inp["env_vars"].([]interface{}); ok && len(v) > 0 {
@@ -315,7 +324,11 @@ func expandInfraProvisionerSpec(p []interface{}) (*gitopspb.InfraProvisionerSpec
 		}
 
 		if v, ok := in["backend_file_path"].([]interface{}); ok {
-			ipSpec.Config.BackendFilePath = expandCommonpbFile(v)
+			backendFilePath, err := expandCommonpbFile(v)
+			if err != nil {
+				return nil, err
+			}
+			ipSpec.Config.BackendFilePath = backendFilePath
 		}
 	}
 
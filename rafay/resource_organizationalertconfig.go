@@ -7,13 +7,10 @@ import (
 	"os"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -26,6 +23,9 @@ func resourceOrganizationAlertConfig() *schema.Resource {
 		ReadContext:   resourceOrganizationAlertConfigRead,
 		UpdateContext: resourceOrganizationAlertConfigUpdate,
 		DeleteContext: resourceOrganizationAlertConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceOrganizationAlertConfigImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -38,6 +38,23 @@ func resourceOrganizationAlertConfig() *schema.Resource {
 	}
 }
 
+// resourceOrganizationAlertConfigImport imports the organization alert
+// configuration by name. It is not project scoped, so unlike
+// resourceMetadataImport the ID carries no project.
+func resourceOrganizationAlertConfigImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	log.Println("resourceOrganizationAlertConfigImport id:", d.Id())
+	if d.Id() == "" {
+		return nil, fmt.Errorf("invalid id, expected the alert configuration name")
+	}
+
+	err := d.Set("metadata", flattenMetaData(&commonpb.Metadata{Name: d.Id()}))
+	if err != nil {
+		log.Println("import set metadata err ", err)
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceOrganizationAlertConfigCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("organization alert config create")
 	diags := resourceOrganizationAlertConfigUpsert(ctx, d, m)
@@ -50,8 +67,7 @@ func resourceOrganizationAlertConfigCreate(ctx context.Context, d *schema.Resour
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -78,8 +94,7 @@ func resourceOrganizationAlertConfigUpsert(ctx context.Context, d *schema.Resour
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -106,8 +121,7 @@ func resourceOrganizationAlertConfigRead(ctx context.Context, d *schema.Resource
 		meta.Name = d.State().ID
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -10,14 +10,11 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/gitopspb"
 	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/RafaySystems/rctl/pkg/pipeline"
-	"github.com/RafaySystems/rctl/pkg/user"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -67,7 +64,7 @@ func resourcePipeline() *schema.Resource {
 		Computed: true,
 		Type:     schema.TypeList,
 	}
-	return &schema.Resource{
+	return withArtifactDigests(&schema.Resource{
 		CreateContext: resourcePipelineCreate,
 		ReadContext:   resourcePipelineRead,
 		UpdateContext: resourcePipelineUpdate,
@@ -84,7 +81,7 @@ func resourcePipeline() *schema.Resource {
 
 		SchemaVersion: 1,
 		Schema:        modSchema,
-	}
+	})
 }
 
 //Stage Spec
@@ -196,26 +193,12 @@ func resourcePipelineCreate(ctx context.Context, d *schema.ResourceData, m inter
 			return diags
 		}
 
-		if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-			defer ResetImpersonateUser()
-			asUser := d.Get("impersonate").(string)
-			// check user role : impersonation not allowed for a user
-			// with ORG Admin role
-			isOrgAdmin, err := user.IsOrgAdmin(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			if isOrgAdmin {
-				return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-			}
-			config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		ctx, err = withImpersonation(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := hubClientFromContext(ctx, m)
 		if err != nil {
 			return diags
 		}
@@ -259,26 +242,12 @@ func resourcePipelineUpsert(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -382,26 +351,12 @@ func resourcePipelineRead(ctx context.Context, d *schema.ResourceData, m interfa
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -446,26 +401,12 @@ func resourcePipelineDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -548,7 +489,10 @@ func expandPipelineSpec(p []interface{}) (*gitopspb.PipelineSpec, error) {
 	}
 	// Triggers  []*TriggerSpec        `protobuf:"bytes,3,rep,name=triggers,proto3" json:"triggers,omitempty"`
 	if v, ok := in["triggers"].([]interface{}); ok && len(v) > 0 {
-		obj.Triggers = expandTriggerSpec(v)
+		obj.Triggers, err = expandTriggerSpec(v)
+		if err != nil {
+			return obj, err
+		}
 	}
 
 	if v, ok := in["sharing"].([]interface{}); ok && len(v) > 0 {
@@ -560,7 +504,10 @@ func expandPipelineSpec(p []interface{}) (*gitopspb.PipelineSpec, error) {
 	}
 
 	if v, ok := in["secret"].([]interface{}); ok {
-		obj.Secret = expandCommonpbFile(v)
+		obj.Secret, err = expandCommonpbFile(v)
+		if err != nil {
+			return obj, err
+		}
 	}
 
 	return obj, nil
@@ -673,7 +620,11 @@ func expandRepo(p []interface{}) (*gitopspb.SystemSyncRepo, error) {
 	}
 
 	if v, ok := in["path"].([]interface{}); ok {
-		obj.Path = expandCommonpbFile(v)
+		path, err := expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
+		obj.Path = path
 	}
 
 	return obj, nil
@@ -775,7 +726,11 @@ func expandStageSpecConfigWorkloadTemplate(p []interface{}) (*gitopspb.StageSpec
 	in := p[0].(map[string]interface{})
 
 	if v, ok := in["overrides"].([]interface{}); ok && len(v) > 0 {
-		obj.WorkloadTemplate.Overrides = expandOverrides(v)
+		overrides, err := expandOverrides(v)
+		if err != nil {
+			return &obj, err
+		}
+		obj.WorkloadTemplate.Overrides = overrides
 		//return &obj, fmt.Errorf("%s", "expandStageSpecConfigWorkloadTemplate overrides not supported in terraform")
 	}
 
@@ -879,13 +834,15 @@ func expandKeyValues(p []interface{}) []*gitopspb.KeyValue {
 	return out
 }
 
-func expandInfraAction(p []interface{}) *gitopspb.InfraProvisionerConfig_Terraform {
+func expandInfraAction(p []interface{}) (*gitopspb.InfraProvisionerConfig_Terraform, error) {
 	obj := &gitopspb.InfraProvisionerConfig_Terraform{}
 	obj.Terraform = &gitopspb.TerraformInfraAction{}
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
+	var err error
+
 	in := p[0].(map[string]interface{})
 
 	if v, ok := in["action"].(string); ok && len(v) > 0 {
@@ -925,22 +882,31 @@ func expandInfraAction(p []interface{}) *gitopspb.InfraProvisionerConfig_Terrafo
 	}
 
 	if v, ok := in["backend_file_path"].([]interface{}); ok && len(v) > 0 {
-		obj.Terraform.BackendFilePath = expandCommonpbFile(v)
+		obj.Terraform.BackendFilePath, err = expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := in["backend_file_path"].([]interface{}); ok && len(v) > 0 {
-		obj.Terraform.BackendFilePath = expandCommonpbFile(v)
+		obj.Terraform.BackendFilePath, err = expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := in["tf_vars_file_path"].([]interface{}); ok && len(v) > 0 {
-		obj.Terraform.TfVarsFilePath = expandCommonpbFile(v)
+		obj.Terraform.TfVarsFilePath, err = expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := in["targets"].([]interface{}); ok && len(v) > 0 {
 		obj.Terraform.Targets = expandTargets(v)
 	}
 
-	return obj
+	return obj, nil
 
 }
 
@@ -978,7 +944,11 @@ func expandStageSpecConfigInfraProvisioner(p []interface{}) (*gitopspb.StageSpec
 	}
 
 	if v, ok := in["action"].([]interface{}); ok && len(v) > 0 {
-		obj.InfraProvisioner.Action = expandInfraAction(v)
+		action, err := expandInfraAction(v)
+		if err != nil {
+			return &obj, err
+		}
+		obj.InfraProvisioner.Action = action
 	}
 
 	w1 := spew.Sprintf("%+v", obj)
@@ -1213,11 +1183,11 @@ func expandWebhookTriggerHelm(p []interface{}) *gitopspb.WebhookTriggerConfig_He
 	return obj
 }
 
-func expandWebhookTriggerGit(p []interface{}) *gitopspb.WebhookTriggerConfig_Git {
+func expandWebhookTriggerGit(p []interface{}) (*gitopspb.WebhookTriggerConfig_Git, error) {
 	obj := &gitopspb.WebhookTriggerConfig_Git{}
 	obj.Git = &gitopspb.GitRepoConfig{}
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
 	in := p[0].(map[string]interface{})
@@ -1235,38 +1205,46 @@ func expandWebhookTriggerGit(p []interface{}) *gitopspb.WebhookTriggerConfig_Git
 	}
 
 	if v, ok := in["paths"].([]interface{}); ok && len(v) > 0 {
-		obj.Git.Paths = expandCommonpbFiles(v)
+		paths, err := expandCommonpbFiles(v)
+		if err != nil {
+			return nil, err
+		}
+		obj.Git.Paths = paths
 	}
 
-	return obj
+	return obj, nil
 }
 
-func expandWebhookTrigger(p []interface{}) *gitopspb.WebhookTriggerConfig {
+func expandWebhookTrigger(p []interface{}) (*gitopspb.WebhookTriggerConfig, error) {
 	obj := &gitopspb.WebhookTriggerConfig{}
 
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
 	in := p[0].(map[string]interface{})
 
 	if v, ok := in["provider"].(string); ok && len(v) > 0 {
 		if v == "Github" || v == "Gitlab" || v == "Bitbucket" || v == "AzureRepos" {
-			obj.Repo = expandWebhookTriggerGit(p)
+			repo, err := expandWebhookTriggerGit(p)
+			if err != nil {
+				return nil, err
+			}
+			obj.Repo = repo
 		}
 
 		if v == "Helm" {
 			obj.Repo = expandWebhookTriggerHelm(p)
 		}
 	}
-	return obj
+	return obj, nil
 }
 
-func expandCronTrigger(p []interface{}) *gitopspb.CronTriggerConfig {
+func expandCronTrigger(p []interface{}) (*gitopspb.CronTriggerConfig, error) {
 	obj := &gitopspb.CronTriggerConfig{}
 
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
 	in := p[0].(map[string]interface{})
@@ -1279,7 +1257,11 @@ func expandCronTrigger(p []interface{}) *gitopspb.CronTriggerConfig {
 
 	if v, ok := in["provider"].(string); ok && len(v) > 0 {
 		if v == "Github" || v == "Gitlab" || v == "Bitbucket" || v == "AzureRepos" {
-			obj.Repo = expandCronTriggerGit(p)
+			repo, err := expandCronTriggerGit(p)
+			if err != nil {
+				return nil, err
+			}
+			obj.Repo = repo
 		}
 
 		if v == "Helm" {
@@ -1288,7 +1270,7 @@ func expandCronTrigger(p []interface{}) *gitopspb.CronTriggerConfig {
 	}
 	log.Println("expandCronTrigger obj ", obj)
 
-	return obj
+	return obj, nil
 }
 
 func expandCronTriggerHelm(p []interface{}) *gitopspb.CronTriggerConfig_Helm {
@@ -1315,11 +1297,11 @@ func expandCronTriggerHelm(p []interface{}) *gitopspb.CronTriggerConfig_Helm {
 	return obj
 }
 
-func expandCronTriggerGit(p []interface{}) *gitopspb.CronTriggerConfig_Git {
+func expandCronTriggerGit(p []interface{}) (*gitopspb.CronTriggerConfig_Git, error) {
 	obj := &gitopspb.CronTriggerConfig_Git{}
 	obj.Git = &gitopspb.GitRepoConfig{}
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
 	in := p[0].(map[string]interface{})
@@ -1337,40 +1319,52 @@ func expandCronTriggerGit(p []interface{}) *gitopspb.CronTriggerConfig_Git {
 	}
 
 	if v, ok := in["paths"].([]interface{}); ok && len(v) > 0 {
-		obj.Git.Paths = expandCommonpbFiles(v)
+		paths, err := expandCommonpbFiles(v)
+		if err != nil {
+			return nil, err
+		}
+		obj.Git.Paths = paths
 	}
 
 	log.Println("expandCronTriggerGit obj ", obj)
-	return obj
+	return obj, nil
 }
 
-func expandTriggerWebhookSpec(p []interface{}) *gitopspb.TriggerSpec_Webhook {
+func expandTriggerWebhookSpec(p []interface{}) (*gitopspb.TriggerSpec_Webhook, error) {
 	obj := &gitopspb.TriggerSpec_Webhook{}
 	obj.Webhook = &gitopspb.WebhookTriggerConfig{}
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
 	in := p[0].(map[string]interface{})
 
 	if v, ok := in["repo"].([]interface{}); ok && len(v) > 0 {
-		obj.Webhook = expandWebhookTrigger(v)
+		trigger, err := expandWebhookTrigger(v)
+		if err != nil {
+			return nil, err
+		}
+		obj.Webhook = trigger
 	}
 
-	return obj
+	return obj, nil
 }
 
-func expandTriggerCronSpec(p []interface{}) *gitopspb.TriggerSpec_Cron {
+func expandTriggerCronSpec(p []interface{}) (*gitopspb.TriggerSpec_Cron, error) {
 	obj := &gitopspb.TriggerSpec_Cron{}
 	obj.Cron = &gitopspb.CronTriggerConfig{}
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
 	in := p[0].(map[string]interface{})
 
 	if v, ok := in["repo"].([]interface{}); ok && len(v) > 0 {
-		obj.Cron = expandCronTrigger(v)
+		trigger, err := expandCronTrigger(v)
+		if err != nil {
+			return nil, err
+		}
+		obj.Cron = trigger
 	}
 
 	if v, ok := in["cron_expression"].(string); ok && len(v) > 0 {
@@ -1379,13 +1373,13 @@ func expandTriggerCronSpec(p []interface{}) *gitopspb.TriggerSpec_Cron {
 	}
 
 	log.Println("expandTriggerCronSpec obj ", obj)
-	return obj
+	return obj, nil
 }
 
 // Trigger Spec Expand Start
-func expandTriggerSpec(p []interface{}) []*gitopspb.TriggerSpec {
+func expandTriggerSpec(p []interface{}) ([]*gitopspb.TriggerSpec, error) {
 	if len(p) == 0 || p[0] == nil {
-		return []*gitopspb.TriggerSpec{}
+		return []*gitopspb.TriggerSpec{}, nil
 	}
 
 	out := make([]*gitopspb.TriggerSpec, len(p))
@@ -1408,18 +1402,26 @@ func expandTriggerSpec(p []interface{}) []*gitopspb.TriggerSpec {
 
 		if vp, ok := in["config"].([]interface{}); ok && len(vp) > 0 {
 			if obj.Type == "Webhook" {
-				obj.Config = expandTriggerWebhookSpec(vp)
+				triggerConfig, err := expandTriggerWebhookSpec(vp)
+				if err != nil {
+					return nil, err
+				}
+				obj.Config = triggerConfig
 			}
 
 			if obj.Type == "Cron" {
-				obj.Config = expandTriggerCronSpec(vp)
+				triggerConfig, err := expandTriggerCronSpec(vp)
+				if err != nil {
+					return nil, err
+				}
+				obj.Config = triggerConfig
 			}
 		}
 
 		out[i] = &obj
 
 	}
-	return out
+	return out, nil
 }
 
 // Flatteners
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
@@ -62,13 +61,12 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 			log.Printf("Project expandProject error")
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := projectClient(ctx, m)
 		if err != nil {
 			return diags
 		}
 
-		err = client.SystemV3().Project().Delete(ctx, options.DeleteOptions{
+		err = client.Delete(ctx, options.DeleteOptions{
 			Name:    pr.Metadata.Name,
 			Project: pr.Metadata.Project,
 		})
@@ -107,13 +105,12 @@ func resourceProjectUpsert(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := projectClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.SystemV3().Project().Apply(ctx, pr, options.ApplyOptions{
+	err = client.Apply(ctx, pr, options.ApplyOptions{
 		FailOnExists: FailOnExists,
 	})
 	if err != nil {
@@ -189,13 +186,12 @@ func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interfac
 	// w1 := spew.Sprintf("%+v", tfProjectState)
 	// log.Println("resourceProjectRead tfProjectState", w1)
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := projectClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	Project, err := client.SystemV3().Project().Get(ctx, options.GetOptions{
+	Project, err := client.Get(ctx, options.GetOptions{
 		Name: meta.Name,
 		//Project: meta.Project,
 	})
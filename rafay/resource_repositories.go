@@ -10,12 +10,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/integrationspb"
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/user"
 	"github.com/davecgh/go-spew/spew"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -30,10 +27,10 @@ type repositorySpec struct {
 	Options     *integrationspb.RepositoryOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
 	Secret      *commonpb.File                    `protobuf:"bytes,5,opt,name=secret,proto3" json:"secret,omitempty"`
 	Credentials struct {
-		Username   string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-		Password   string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-		PrivateKey string `protobuf:"bytes,1,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
-		AppID      string `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID,omitempty"`
+		Username       string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+		Password       string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+		PrivateKey     string `protobuf:"bytes,1,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+		AppID          string `protobuf:"bytes,3,opt,name=appID,proto3" json:"appID,omitempty"`
 		InstallationID string `protobuf:"bytes,4,opt,name=installationID,proto3" json:"installationID,omitempty"`
 	} `json:"credentials,omitempty"`
 	Sharing *commonpb.SharingSpec `protobuf:"bytes,5,opt,name=sharing,proto3" json:"sharing,omitempty"`
@@ -46,11 +43,14 @@ func resourceRepositories() *schema.Resource {
 		Optional:    true,
 		Type:        schema.TypeString,
 	}
-	return &schema.Resource{
+	return withProjectIdentity(&schema.Resource{
 		CreateContext: resourceRepositoriesCreate,
 		ReadContext:   resourceRepositoriesRead,
 		UpdateContext: resourceRepositoriesUpdate,
 		DeleteContext: resourceRepositoriesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -60,7 +60,7 @@ func resourceRepositories() *schema.Resource {
 
 		SchemaVersion: 1,
 		Schema:        modSchema,
-	}
+	})
 }
 
 func resourceRepositoriesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -91,26 +91,12 @@ func resourceRepositoryUpsert(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -154,26 +140,12 @@ func resourceRepositoriesRead(ctx context.Context, d *schema.ResourceData, m int
 	w1 := spew.Sprintf("%+v", repoTFState)
 	log.Println("resourceRepositoriesRead repoTFState", w1)
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -221,26 +193,12 @@ func resourceRepositoriesDelete(ctx context.Context, d *schema.ResourceData, m i
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -312,14 +270,22 @@ func expandRepositorySpec(p []interface{}) (*integrationspb.RepositorySpec, erro
 
 	if v, ok := in["options"].([]interface{}); ok && len(v) > 0 {
 		//obj.Options = expandRepositoryOptions(v)
-		repoSpec.Options = expandRepositoryOptions(v)
+		repoOptions, err := expandRepositoryOptions(v)
+		if err != nil {
+			return nil, err
+		}
+		repoSpec.Options = repoOptions
 	} else {
-		repoSpec.Options = expandRepositoryOptions(nil)
+		repoSpec.Options, _ = expandRepositoryOptions(nil)
 	}
 
 	if v, ok := in["secret"].([]interface{}); ok {
 		//obj.Secret = expandCommonpbFile(v)
-		repoSpec.Secret = expandCommonpbFile(v)
+		secret, err := expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
+		repoSpec.Secret = secret
 	}
 
 	if vp, ok := in["credentials"].([]interface{}); ok && len(vp) > 0 {
@@ -375,10 +341,10 @@ func expandRepositorySpec(p []interface{}) (*integrationspb.RepositorySpec, erro
 	return &obj, nil
 }
 
-func expandRepositoryOptions(p []interface{}) *integrationspb.RepositoryOptions {
+func expandRepositoryOptions(p []interface{}) (*integrationspb.RepositoryOptions, error) {
 	obj := &integrationspb.RepositoryOptions{}
 	if len(p) == 0 || p[0] == nil {
-		return obj
+		return obj, nil
 	}
 
 	in := p[0].(map[string]interface{})
@@ -413,10 +379,14 @@ func expandRepositoryOptions(p []interface{}) *integrationspb.RepositoryOptions
 	}
 
 	if v, ok := in["ca_cert"].([]interface{}); ok {
-		obj.CaCert = expandCommonpbFile(v)
+		caCert, err := expandCommonpbFile(v)
+		if err != nil {
+			return nil, err
+		}
+		obj.CaCert = caCert
 	}
 
-	return obj
+	return obj, nil
 }
 
 // Flatteners
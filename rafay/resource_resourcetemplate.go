@@ -9,11 +9,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	"github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/eaaspb"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -58,8 +56,7 @@ func resourceTemplateUpsert(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -89,8 +86,7 @@ func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, m any) di
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -152,8 +148,7 @@ func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, m any)
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -227,7 +222,10 @@ func expandResourceTemplateSpec(p []any) (*eaaspb.ResourceTemplateSpec, error) {
 	}
 
 	if v, ok := in["contexts"].([]any); ok && len(v) > 0 {
-		spec.Contexts = expandContexts(v)
+		spec.Contexts, err = expandContexts(v)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	if v, ok := in["variables"].([]any); ok && len(v) > 0 {
@@ -362,10 +360,10 @@ func expandResourceTemplateRepositoryOptions(p []any) *eaaspb.ResourceTemplateRe
 	return ro
 }
 
-func expandContexts(p []any) []*eaaspb.ConfigContextCompoundRef {
+func expandContexts(p []any) ([]*eaaspb.ConfigContextCompoundRef, error) {
 	ctxs := make([]*eaaspb.ConfigContextCompoundRef, 0)
 	if len(p) == 0 {
-		return ctxs
+		return ctxs, nil
 	}
 
 	for indx := range p {
@@ -378,13 +376,17 @@ func expandContexts(p []any) []*eaaspb.ConfigContextCompoundRef {
 		}
 
 		if v, ok := in["data"].([]any); ok && len(v) > 0 {
-			obj.Data = expandConfigContextInline(v)
+			data, err := expandConfigContextInline(v)
+			if err != nil {
+				return nil, err
+			}
+			obj.Data = data
 		}
 
 		ctxs = append(ctxs, obj)
 	}
 
-	return ctxs
+	return ctxs, nil
 }
 
 func expandResourceHooks(p []any) (*eaaspb.ResourceHooks, error) {
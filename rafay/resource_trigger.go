@@ -2,6 +2,7 @@ package rafay
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
@@ -27,6 +28,9 @@ func resourceTrigger() *schema.Resource {
 		ReadContext:   resourceTriggerRead,
 		UpdateContext: resourceTriggerUpdate,
 		DeleteContext: resourceTriggerDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTriggerImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -48,6 +52,22 @@ func resourceTrigger() *schema.Resource {
 	}
 }
 
+// resourceTriggerImport imports a trigger by `name/project`. The trigger
+// definition itself stays in the file referenced by trigger_filepath.
+func resourceTriggerImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("invalid id %s, expected name/project", d.Id())
+	}
+	log.Println("resourceTriggerImport idParts:", idParts)
+
+	if err := d.Set("projectname", idParts[1]); err != nil {
+		return nil, err
+	}
+	d.SetId(idParts[0])
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceTriggerCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	filePath := d.Get("trigger_filepath").(string)
@@ -9,11 +9,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	"github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/eaaspb"
-	"github.com/RafaySystems/rctl/pkg/config"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -56,8 +54,7 @@ func resourceWorkflowHandlerCreate(ctx context.Context, d *schema.ResourceData,
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := getHubClient(m)
 		if err != nil {
 			return diags
 		}
@@ -86,8 +83,7 @@ func resourceWorkflowHandlerUpsert(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -117,8 +113,7 @@ func resourceWorkflowHandlerRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -179,8 +174,7 @@ func resourceWorkflowHandlerDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, TF_USER_AGENT, options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -237,7 +231,11 @@ func expandWorkflowHandlerSpec(p []any) (*eaaspb.WorkflowHandlerSpec, error) {
 	}
 
 	if v, ok := in["inputs"].([]any); ok && len(v) > 0 {
-		spec.Inputs = expandConfigContextCompoundRefs(v)
+		inputs, err := expandConfigContextCompoundRefs(v)
+		if err != nil {
+			return nil, err
+		}
+		spec.Inputs = inputs
 	}
 
 	if v, ok := in["icon_url"].(string); ok {
@@ -1068,7 +1066,11 @@ func expandWorkflowHandlerInline(p []any) (*eaaspb.WorkflowHandlerInline, error)
 	}
 
 	if v, ok := in["inputs"].([]any); ok && len(v) > 0 {
-		wfHandlerInline.Inputs = expandConfigContextCompoundRefs(v)
+		inputs, err := expandConfigContextCompoundRefs(v)
+		if err != nil {
+			return nil, err
+		}
+		wfHandlerInline.Inputs = inputs
 	}
 
 	if v, ok := in["outputs"].(string); ok && len(v) > 0 {
@@ -8,15 +8,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/user"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	"github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/appspb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -30,11 +25,14 @@ func resourceWorkload() *schema.Resource {
 		Optional:    true,
 		Type:        schema.TypeString,
 	}
-	return &schema.Resource{
+	return withProjectIdentity(withArtifactDigests(&schema.Resource{
 		CreateContext: resourceWorkloadCreate,
 		ReadContext:   resourceWorkloadRead,
 		UpdateContext: resourceWorkloadUpdate,
 		DeleteContext: resourceWorkloadDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -44,11 +42,11 @@ func resourceWorkload() *schema.Resource {
 
 		SchemaVersion: 1,
 		Schema:        modSchema,
-	}
+	}))
 }
 
 func resourceWorkloadCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	create := isWorkloadAlreadyCreated(ctx, d)
+	create := isWorkloadAlreadyCreated(ctx, d, m)
 	diags := resourceWorkloadUpsert(ctx, d, m)
 	if diags.HasError() && !create {
 		if checkStandardInputTextError(diags[0].Summary) {
@@ -65,31 +63,17 @@ func resourceWorkloadCreate(ctx context.Context, d *schema.ResourceData, m inter
 			return diags
 		}
 
-		if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-			defer ResetImpersonateUser()
-			asUser := d.Get("impersonate").(string)
-			// check user role : impersonation not allowed for a user
-			// with ORG Admin role
-			isOrgAdmin, err := user.IsOrgAdmin(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			if isOrgAdmin {
-				return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-			}
-			config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		ctx, err = withImpersonation(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := workloadClient(ctx, m)
 		if err != nil {
 			return diags
 		}
 
-		err = client.AppsV3().Workload().Delete(ctx, options.DeleteOptions{
+		err = client.Delete(ctx, options.DeleteOptions{
 			Name:    wl.Metadata.Name,
 			Project: wl.Metadata.Project,
 		})
@@ -126,59 +110,55 @@ func resourceWorkloadUpsert(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := workloadClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.AppsV3().Workload().Apply(ctx, wl, options.ApplyOptions{})
+	err = client.Apply(ctx, wl, options.ApplyOptions{})
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	// wait for publish
-	for {
-		time.Sleep(60 * time.Second)
-		wls, err := client.AppsV3().Workload().Status(ctx, options.StatusOptions{
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+	err = pollUntil(ctx, pollSpec{
+		Operation:    "workload publish",
+		Timeout:      timeout,
+		InitialDelay: 10 * time.Second,
+		MinInterval:  15 * time.Second,
+		MaxInterval:  60 * time.Second,
+	}, func(ctx context.Context) (bool, string, error) {
+		wls, err := client.Status(ctx, options.StatusOptions{
 			Name:    wl.Metadata.Name,
 			Project: wl.Metadata.Project,
 		})
 		if err != nil {
-			return diag.FromErr(err)
+			return false, "", err
 		}
 		log.Println("wls.Status", wls.Status)
-		if wls.Status != nil {
-			//check if workload can be placed on a cluster, if true break out of loop
-			if wls.Status.ConditionStatus == commonpb.ConditionStatus_StatusOK ||
-				wls.Status.ConditionStatus == commonpb.ConditionStatus_StatusNotSet {
-				break
-			}
-			if wls.Status.ConditionStatus == commonpb.ConditionStatus_StatusFailed {
-				return diag.FromErr(fmt.Errorf("%s %s", "failed to publish workload", wls.Status))
-			}
-		} else {
-			break
+		if wls.Status == nil {
+			return true, "", nil
 		}
-
+		//check if workload can be placed on a cluster
+		switch wls.Status.ConditionStatus {
+		case commonpb.ConditionStatus_StatusOK, commonpb.ConditionStatus_StatusNotSet:
+			return true, wls.Status.ConditionStatus.String(), nil
+		case commonpb.ConditionStatus_StatusFailed:
+			return false, "", fmt.Errorf("%s %s", "failed to publish workload", wls.Status)
+		}
+		return false, fmt.Sprintf("%s %s", wls.Status.ConditionStatus, wls.Status.Reason), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
 	d.SetId(wl.Metadata.Name)
@@ -207,31 +187,17 @@ func resourceWorkloadRead(ctx context.Context, d *schema.ResourceData, m interfa
 	// w1 := spew.Sprintf("%+v", tfWorkloadState)
 	// log.Println("resourceWorkloadRead tfWorkloadState", w1)
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := workloadClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	wl, err := client.AppsV3().Workload().Get(ctx, options.GetOptions{
+	wl, err := client.Get(ctx, options.GetOptions{
 		//Name:    tfWorkloadState.Metadata.Name,
 		Name:    meta.Name,
 		Project: tfWorkloadState.Metadata.Project,
@@ -279,31 +245,17 @@ func resourceWorkloadDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := workloadClient(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.AppsV3().Workload().Delete(ctx, options.DeleteOptions{
+	err = client.Delete(ctx, options.DeleteOptions{
 		Name:    wl.Metadata.Name,
 		Project: wl.Metadata.Project,
 	})
@@ -460,20 +412,19 @@ func flattenWorkloadSpec(dataResource bool, in *appspb.WorkloadSpec, p []interfa
 	return []interface{}{obj}, nil
 }
 
-func isWorkloadAlreadyCreated(ctx context.Context, d *schema.ResourceData) bool {
+func isWorkloadAlreadyCreated(ctx context.Context, d *schema.ResourceData, m interface{}) bool {
 
 	meta := GetMetaData(d)
 	if meta == nil {
 		return false
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := workloadClient(ctx, m)
 	if err != nil {
 		return false
 	}
 
-	_, err = client.AppsV3().Workload().Get(ctx, options.GetOptions{
+	_, err = client.Get(ctx, options.GetOptions{
 		Name:    meta.Name,
 		Project: meta.Project,
 	})
@@ -9,12 +9,9 @@ import (
 	"time"
 
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/appspb"
 	"github.com/RafaySystems/rctl/pkg/config"
-	"github.com/RafaySystems/rctl/pkg/user"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 	"github.com/RafaySystems/rctl/pkg/workloadtemplate"
 	"github.com/davecgh/go-spew/spew"
 
@@ -30,11 +27,14 @@ func resourceWorkloadTemplate() *schema.Resource {
 		Optional:    true,
 		Type:        schema.TypeString,
 	}
-	return &schema.Resource{
+	return withArtifactDigests(&schema.Resource{
 		CreateContext: resourceWorkloadTemplateCreate,
 		ReadContext:   resourceWorkloadTemplateRead,
 		UpdateContext: resourceWorkloadTemplateUpdate,
 		DeleteContext: resourceWorkloadTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceMetadataImport,
+		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -44,7 +44,7 @@ func resourceWorkloadTemplate() *schema.Resource {
 
 		SchemaVersion: 1,
 		Schema:        modSchema,
-	}
+	})
 }
 
 func resourceWorkloadTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -62,26 +62,12 @@ func resourceWorkloadTemplateCreate(ctx context.Context, d *schema.ResourceData,
 			return diags
 		}
 
-		if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-			defer ResetImpersonateUser()
-			asUser := d.Get("impersonate").(string)
-			// check user role : impersonation not allowed for a user
-			// with ORG Admin role
-			isOrgAdmin, err := user.IsOrgAdmin(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			if isOrgAdmin {
-				return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-			}
-			config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		ctx, err = withImpersonation(ctx, d, m)
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		client, err := hubClientFromContext(ctx, m)
 		if err != nil {
 			return diags
 		}
@@ -125,26 +111,12 @@ func resourceWorkloadTemplateUpsert(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -185,26 +157,12 @@ func resourceWorkloadTemplateRead(ctx context.Context, d *schema.ResourceData, m
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -243,26 +201,12 @@ func resourceWorkloadTemplateDelete(ctx context.Context, d *schema.ResourceData,
 		return diag.FromErr(err)
 	}
 
-	if v, ok := d.Get("impersonate").(string); ok && len(v) > 0 {
-		defer ResetImpersonateUser()
-		asUser := d.Get("impersonate").(string)
-		// check user role : impersonation not allowed for a user
-		// with ORG Admin role
-		isOrgAdmin, err := user.IsOrgAdmin(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
-		if isOrgAdmin {
-			return diag.FromErr(fmt.Errorf("%s", "--as-user cannot have ORGADMIN role"))
-		}
-		config.ApiKey, config.ApiSecret, err = user.GetUserAPIKey(asUser)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
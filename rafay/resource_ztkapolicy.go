@@ -7,14 +7,10 @@ import (
 	"os"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -22,6 +18,12 @@ import (
 )
 
 func resourceZTKAPolicy() *schema.Resource {
+	modSchema := copySchemaMap(resource.ZTKAPolicySchema.Schema)
+	modSchema["impersonate"] = &schema.Schema{
+		Description: "impersonate user",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
 	return &schema.Resource{
 		CreateContext: resourceZTKAPolicyCreate,
 		ReadContext:   resourceZTKAPolicyRead,
@@ -38,7 +40,7 @@ func resourceZTKAPolicy() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema:        resource.ZTKAPolicySchema.Schema,
+		Schema:        modSchema,
 	}
 }
 
@@ -73,7 +75,7 @@ func resourceZTKAPolicyImport(d *schema.ResourceData, meta interface{}) ([]*sche
 
 func resourceZTKAPolicyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("ztka policy create")
-	create := isZTKAPolicyAlreadyExists(ctx, d)
+	create := isZTKAPolicyAlreadyExists(ctx, d, m)
 	diags := resourceZTKAPolicyUpsert(ctx, d, m)
 	if diags.HasError() && !create {
 		tflog := os.Getenv("TF_LOG")
@@ -84,8 +86,12 @@ func resourceZTKAPolicyCreate(ctx context.Context, d *schema.ResourceData, m int
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		ctx, err = withImpersonation(ctx, d, m)
+		if err != nil {
+			return diags
+		}
+
+		client, err := hubClientFromContext(ctx, m)
 		if err != nil {
 			return diags
 		}
@@ -122,8 +128,12 @@ func resourceZTKAPolicyUpsert(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -150,8 +160,12 @@ func resourceZTKAPolicyRead(ctx context.Context, d *schema.ResourceData, m inter
 		meta.Name = d.State().ID
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	ctx, err := withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -194,8 +208,12 @@ func resourceZTKAPolicyDelete(ctx context.Context, d *schema.ResourceData, m int
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -367,14 +385,13 @@ func flattenZTKARuleList(input []*systempb.ZTKAPolicyRule, p []interface{}) []in
 	return out
 }
 
-func isZTKAPolicyAlreadyExists(ctx context.Context, d *schema.ResourceData) bool {
+func isZTKAPolicyAlreadyExists(ctx context.Context, d *schema.ResourceData, m interface{}) bool {
 	meta := GetMetaData(d)
 	if meta == nil {
 		return false
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return false
 	}
@@ -387,4 +404,4 @@ func isZTKAPolicyAlreadyExists(ctx context.Context, d *schema.ResourceData) bool
 	}
 
 	return true
-}
\ No newline at end of file
+}
@@ -8,14 +8,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/RafaySystems/rctl/pkg/config"
-
 	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
-	typed "github.com/RafaySystems/rafay-common/pkg/hub/client/typed"
 	"github.com/RafaySystems/rafay-common/pkg/hub/terraform/resource"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/commonpb"
 	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
-	"github.com/RafaySystems/rctl/pkg/versioninfo"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 
@@ -25,6 +21,12 @@ import (
 var showZTKAArtifactFlag bool = false
 
 func resourceZTKARule() *schema.Resource {
+	modSchema := copySchemaMap(resource.ZTKARuleSchema.Schema)
+	modSchema["impersonate"] = &schema.Schema{
+		Description: "impersonate user",
+		Optional:    true,
+		Type:        schema.TypeString,
+	}
 	return &schema.Resource{
 		CreateContext: resourceZTKARuleCreate,
 		ReadContext:   resourceZTKARuleRead,
@@ -41,7 +43,7 @@ func resourceZTKARule() *schema.Resource {
 		},
 
 		SchemaVersion: 1,
-		Schema:        resource.ZTKARuleSchema.Schema,
+		Schema:        modSchema,
 	}
 }
 
@@ -78,7 +80,7 @@ func resourceZTKARuleImport(d *schema.ResourceData, meta interface{}) ([]*schema
 
 func resourceZTKARuleCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	log.Println("ztka rule create")
-	create := isZTKARuleAlreadyExists(ctx, d)
+	create := isZTKARuleAlreadyExists(ctx, d, m)
 	diags := resourceZTKARuleUpsert(ctx, d, m)
 	if diags.HasError() && !create {
 		tflog := os.Getenv("TF_LOG")
@@ -89,8 +91,12 @@ func resourceZTKARuleCreate(ctx context.Context, d *schema.ResourceData, m inter
 		if err != nil {
 			return diags
 		}
-		auth := config.GetConfig().GetAppAuthProfile()
-		client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+		ctx, err = withImpersonation(ctx, d, m)
+		if err != nil {
+			return diags
+		}
+
+		client, err := hubClientFromContext(ctx, m)
 		if err != nil {
 			return diags
 		}
@@ -127,8 +133,12 @@ func resourceZTKARuleUpsert(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -155,8 +165,12 @@ func resourceZTKARuleRead(ctx context.Context, d *schema.ResourceData, m interfa
 		meta.Name = d.State().ID
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	ctx, err := withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		log.Println("read client err")
 		return diag.FromErr(err)
@@ -199,8 +213,12 @@ func resourceZTKARuleDelete(ctx context.Context, d *schema.ResourceData, m inter
 		return diag.FromErr(err)
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	ctx, err = withImpersonation(ctx, d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client, err := hubClientFromContext(ctx, m)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -432,14 +450,13 @@ func flattenClusterSelector(in *systempb.ZTKAClusters, p []interface{}) []interf
 	return []interface{}{obj}
 }
 
-func isZTKARuleAlreadyExists(ctx context.Context, d *schema.ResourceData) bool {
+func isZTKARuleAlreadyExists(ctx context.Context, d *schema.ResourceData, m interface{}) bool {
 	meta := GetMetaData(d)
 	if meta == nil {
 		return false
 	}
 
-	auth := config.GetConfig().GetAppAuthProfile()
-	client, err := typed.NewClientWithUserAgent(auth.URL, auth.Key, versioninfo.GetUserAgent(), options.WithInsecureSkipVerify(auth.SkipServerCertValid))
+	client, err := getHubClient(m)
 	if err != nil {
 		return false
 	}
@@ -451,4 +468,4 @@ func isZTKARuleAlreadyExists(ctx context.Context, d *schema.ResourceData) bool {
 		return false
 	}
 	return true
-}
\ No newline at end of file
+}
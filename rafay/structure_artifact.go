@@ -98,7 +98,10 @@ func ExpandArtifact(artifactType string, ap []interface{}) (*commonpb.ArtifactSp
 		}
 
 		if v, ok := in["chart_path"].([]interface{}); ok && len(v) > 0 {
-			at.Artifact.ChartPath = expandFile(v)
+			at.Artifact.ChartPath, err = expandFile(v)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if v, ok := in["chart_version"].(string); ok && len(v) > 0 {
@@ -106,7 +109,10 @@ func ExpandArtifact(artifactType string, ap []interface{}) (*commonpb.ArtifactSp
 		}
 
 		if v, ok := in["configmap"].([]interface{}); ok && len(v) > 0 {
-			at.Artifact.Configmap = expandFile(v)
+			at.Artifact.Configmap, err = expandFile(v)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if v, ok := in["path"].(string); ok && len(v) > 0 {
@@ -114,7 +120,10 @@ func ExpandArtifact(artifactType string, ap []interface{}) (*commonpb.ArtifactSp
 		}
 
 		if v, ok := in["file"].([]interface{}); ok && len(v) > 0 {
-			at.Artifact.File = expandFile(v)
+			at.Artifact.File, err = expandFile(v)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if v, ok := in["directory"].(string); ok && len(v) > 0 {
@@ -122,7 +131,10 @@ func ExpandArtifact(artifactType string, ap []interface{}) (*commonpb.ArtifactSp
 		}
 
 		if v, ok := in["configuration"].([]interface{}); ok && len(v) > 0 {
-			at.Artifact.Configuration = expandFile(v)
+			at.Artifact.Configuration, err = expandFile(v)
+			if err != nil {
+				return nil, err
+			}
 			artfct = spew.Sprintf("%+v", at.Artifact.Configuration)
 			log.Println("ExpandArtifact  at.Artifact.Configuration ", artfct)
 		}
@@ -149,11 +161,17 @@ func ExpandArtifact(artifactType string, ap []interface{}) (*commonpb.ArtifactSp
 		}
 
 		if v, ok := in["secret"].([]interface{}); ok && len(v) > 0 {
-			at.Artifact.Secret = expandFile(v)
+			at.Artifact.Secret, err = expandFile(v)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if v, ok := in["statefulset"].([]interface{}); ok && len(v) > 0 {
-			at.Artifact.Statefulset = expandFile(v)
+			at.Artifact.Statefulset, err = expandFile(v)
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if v, ok := in["url"].([]interface{}); ok && len(v) > 0 {
@@ -23,7 +23,7 @@ func TestResourceFleetPlanUpdate(ctx context.Context, d *schema.ResourceData, m
 }
 
 func TestResourceFleetPlanUpsert(ctx context.Context, d *schema.ResourceData) diag.Diagnostics {
-	return resourceFleetPlanUpsert(ctx, d)
+	return resourceFleetPlanUpsert(ctx, d, nil)
 }
 
 func TestResourceFleetPlanRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -105,7 +105,7 @@ func TestUpdateFleetPlanJob(ctx context.Context, d *schema.ResourceData, m inter
 }
 
 func TestUpsertFleetPlanJob(ctx context.Context, d *schema.ResourceData) diag.Diagnostics {
-	return upsertFleetPlanJob(ctx, d)
+	return upsertFleetPlanJob(ctx, d, nil)
 }
 
 func TestReadFleetPlanJob(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
@@ -396,7 +396,11 @@ func expandOverridesRepo(p []interface{}) (*gitopspb.OverrideTemplate_Repo, erro
 	}
 
 	if v, ok := in["paths"].([]interface{}); ok && len(v) > 0 {
-		obj.Repo.Paths = expandCommonpbFiles(v)
+		paths, err := expandCommonpbFiles(v)
+		if err != nil {
+			return &obj, err
+		}
+		obj.Repo.Paths = paths
 	}
 
 	return &obj, nil
@@ -421,9 +425,9 @@ func expandOverridesInline(p []interface{}) (*gitopspb.OverrideTemplate_Inline,
 	return &obj, nil
 }
 
-func expandOverrides(p []interface{}) []*gitopspb.OverrideTemplate {
+func expandOverrides(p []interface{}) ([]*gitopspb.OverrideTemplate, error) {
 	if len(p) == 0 || p[0] == nil {
-		return []*gitopspb.OverrideTemplate{}
+		return []*gitopspb.OverrideTemplate{}, nil
 	}
 
 	out := make([]*gitopspb.OverrideTemplate, len(p))
@@ -441,20 +445,24 @@ func expandOverrides(p []interface{}) []*gitopspb.OverrideTemplate {
 
 		if vp, ok := in["template"].([]interface{}); ok && len(vp) > 0 {
 			if len(vp) == 0 || vp[0] == nil {
-				return nil
+				return nil, nil
 			}
 			in := vp[0].(map[string]interface{})
 			if v, ok := in["inline"].(string); ok && len(v) > 0 {
 				obj.Template, _ = expandOverridesInline(vp)
 			} else {
-				obj.Template, _ = expandOverridesRepo(vp)
+				repo, err := expandOverridesRepo(vp)
+				if err != nil {
+					return nil, err
+				}
+				obj.Template = repo
 			}
 		}
 
 		out[i] = &obj
 	}
 
-	return out
+	return out, nil
 }
 
 func expandAgents(p []interface{}) []*integrationspb.AgentMeta {
@@ -481,10 +489,10 @@ func expandAgents(p []interface{}) []*integrationspb.AgentMeta {
 	return out
 }
 
-func expandFile(p []interface{}) *File {
+func expandFile(p []interface{}) (*File, error) {
 	obj := File{}
 	if len(p) == 0 || p[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	in := p[0].(map[string]interface{})
@@ -497,24 +505,20 @@ func expandFile(p []interface{}) *File {
 	}
 
 	if strings.HasPrefix(obj.Name, "file://") {
-		//get full path of artifact
-		artifactFullPath := filepath.Join(filepath.Dir("."), obj.Name[7:])
-		//retrieve artifact data
-		artifactData, err := ioutil.ReadFile(artifactFullPath)
+		artifactData, err := readArtifactFile(obj.Name)
 		if err != nil {
-			log.Println("unable to read artifact at ", artifactFullPath)
-		} else {
-			obj.Data = artifactData
+			return nil, err
 		}
+		obj.Data = artifactData
 	}
 
-	return &obj
+	return &obj, nil
 }
 
-func expandCommonpbFile(p []interface{}) *commonpb.File {
+func expandCommonpbFile(p []interface{}) (*commonpb.File, error) {
 	obj := commonpb.File{}
 	if len(p) == 0 || p[0] == nil {
-		return nil
+		return nil, nil
 	}
 
 	in := p[0].(map[string]interface{})
@@ -523,23 +527,19 @@ func expandCommonpbFile(p []interface{}) *commonpb.File {
 	}
 
 	if strings.HasPrefix(obj.Name, "file://") {
-		//get full path of artifact
-		artifactFullPath := filepath.Join(filepath.Dir("."), obj.Name[7:])
-		//retrieve artifact data
-		artifactData, err := ioutil.ReadFile(artifactFullPath)
+		artifactData, err := readArtifactFile(obj.Name)
 		if err != nil {
-			log.Println("unable to read artifact at ", artifactFullPath)
-		} else {
-			obj.Data = artifactData
+			return nil, err
 		}
+		obj.Data = artifactData
 	}
 
-	return &obj
+	return &obj, nil
 }
 
-func expandCommonpbFiles(p []interface{}) []*commonpb.File {
+func expandCommonpbFiles(p []interface{}) ([]*commonpb.File, error) {
 	if len(p) == 0 || p[0] == nil {
-		return nil
+		return nil, nil
 	}
 	out := make([]*commonpb.File, len(p))
 
@@ -550,15 +550,11 @@ func expandCommonpbFiles(p []interface{}) []*commonpb.File {
 		if name, ok := in["name"].(string); ok && len(name) > 0 {
 
 			if strings.HasPrefix(name, "file://") {
-				//get full path of artifact
-				artifactFullPath := filepath.Join(filepath.Dir("."), name[7:])
-				//retrieve artifact data
-				artifactData, err := ioutil.ReadFile(artifactFullPath)
+				artifactData, err := readArtifactFile(name)
 				if err != nil {
-					log.Println("unable to read artifact at ", artifactFullPath)
-				} else {
-					obj.Data = artifactData
+					return nil, err
 				}
+				obj.Data = artifactData
 				obj.Name = strings.TrimPrefix(name, "file://")
 			} else {
 				obj.Name = name
@@ -583,7 +579,7 @@ func expandCommonpbFiles(p []interface{}) []*commonpb.File {
 		out[i] = &obj
 	}
 
-	return out
+	return out, nil
 }
 
 func expandFiles(p []interface{}) ([]*File, error) {
@@ -602,16 +598,11 @@ func expandFiles(p []interface{}) ([]*File, error) {
 		}
 
 		if strings.HasPrefix(of.Name, "file://") {
-			//get full path of artifact
-			artifactFullPath := filepath.Join(filepath.Dir("."), of.Name[7:])
-			//retrieve artifact data
-			artifactData, err := ioutil.ReadFile(artifactFullPath)
+			artifactData, err := readArtifactFile(of.Name)
 			if err != nil {
-				log.Println("unable to read artifact at ", artifactFullPath)
 				return nil, err
-			} else {
-				of.Data = artifactData
 			}
+			of.Data = artifactData
 		} else if strings.HasPrefix(of.Name, "temp://") {
 			//get full path of artifact
 			artifactFullPath := filepath.Join(filepath.Dir("."), of.Name[7:])
@@ -1264,13 +1255,6 @@ func flattenResourceQuantity(in *commonpb.ResourceQuantity) []interface{} {
 	return []interface{}{obj}
 }
 
-func ResetImpersonateUser() {
-	log.Println("ResetImpersonateUser")
-	config.ApiKey = ""
-	config.ApiSecret = ""
-	config.ResetOrigConfig()
-}
-
 func ReadMetaName(p []interface{}) string {
 	if p == nil || len(p) == 0 || p[0] == nil {
 		return ""
@@ -1325,6 +1309,31 @@ func GetMetaData(in *schema.ResourceData) *commonpb.Metadata {
 	return nil
 }
 
+// resourceMetadataImport is the importer of project scoped resources that are
+// identified by their `metadata` block. The import ID is `name/project`, the
+// same format rafay_namespace and resourceEnvironmentImport accept;
+// Read fills in everything else from the controller.
+func resourceMetadataImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	idParts := strings.SplitN(d.Id(), "/", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return nil, fmt.Errorf("invalid id %s, expected name/project", d.Id())
+	}
+	log.Println("resourceMetadataImport idParts:", idParts)
+
+	metadata := commonpb.Metadata{
+		Name:    idParts[0],
+		Project: idParts[1],
+	}
+
+	err := d.Set("metadata", flattenMetaData(&metadata))
+	if err != nil {
+		log.Println("import set metadata err ", err)
+		return nil, err
+	}
+	d.SetId(metadata.Name)
+	return []*schema.ResourceData{d}, nil
+}
+
 func expandVariables(p []interface{}) []*eaaspb.Variable {
 	if len(p) == 0 || p[0] == nil {
 		return []*eaaspb.Variable{}
@@ -2542,7 +2551,10 @@ func expandActions(p []interface{}) ([]*eaaspb.Action, error) {
 		}
 
 		if v, ok := in["context"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
-			action.Context = expandConfigContextCompoundRef(v[0].(map[string]any))
+			action.Context, err = expandConfigContextCompoundRef(v[0].(map[string]any))
+			if err != nil {
+				return nil, err
+			}
 		}
 
 		if h, ok := in["workflows"].([]interface{}); ok && len(h) > 0 {
@@ -0,0 +1,244 @@
+package deprecations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Finding is a use of a deprecated or removed resource, data source or
+// attribute in a Terraform configuration.
+type Finding struct {
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	// Address is the configuration address of the block, e.g.
+	// rafay_eks_cluster.prod or data.rafay_namespaces.all.
+	Address     string `json:"address"`
+	Field       string `json:"field,omitempty"`
+	Kind        string `json:"kind"`
+	Message     string `json:"message"`
+	Replacement string `json:"replacement,omitempty"`
+}
+
+func (f Finding) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s:%d,%d: %s", f.File, f.Line, f.Column, f.Address)
+	if f.Field != "" {
+		fmt.Fprintf(&b, ": %s", f.Field)
+	}
+	switch f.Kind {
+	case KindRemoved:
+		b.WriteString(" is removed")
+	default:
+		b.WriteString(" is deprecated")
+	}
+	fmt.Fprintf(&b, ": %s", f.Message)
+	if f.Replacement != "" {
+		fmt.Fprintf(&b, " (replace with %s)", f.Replacement)
+	}
+	return b.String()
+}
+
+// Linter reports the uses of a set of deprecations in Terraform
+// configurations.
+type Linter struct {
+	resources   map[string][]Deprecation
+	dataSources map[string][]Deprecation
+}
+
+// NewLinter returns a Linter for the deprecations found by ScanDirectory.
+func NewLinter(deprecations []Deprecation) *Linter {
+	l := &Linter{
+		resources:   map[string][]Deprecation{},
+		dataSources: map[string][]Deprecation{},
+	}
+	for _, d := range deprecations {
+		if d.DataSource {
+			l.dataSources[d.Resource] = append(l.dataSources[d.Resource], d)
+		} else {
+			l.resources[d.Resource] = append(l.resources[d.Resource], d)
+		}
+	}
+	return l
+}
+
+// LintDirectory lints the .tf files under root, skipping the .terraform
+// directories of initialized modules. Findings are sorted by file and line.
+func (l *Linter) LintDirectory(root string) ([]Finding, error) {
+	var findings []Finding
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".terraform" {
+			return filepath.SkipDir
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".tf") {
+			return nil
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		found, diags := l.LintFile(path, src)
+		if diags.HasErrors() {
+			return diags
+		}
+		findings = append(findings, found...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		if findings[i].Line != findings[j].Line {
+			return findings[i].Line < findings[j].Line
+		}
+		return findings[i].Column < findings[j].Column
+	})
+	return findings, nil
+}
+
+// LintFile lints the Terraform configuration src read from filename.
+func (l *Linter) LintFile(filename string, src []byte) ([]Finding, hcl.Diagnostics) {
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, nil
+	}
+
+	var findings []Finding
+	for _, block := range body.Blocks {
+		if len(block.Labels) != 2 {
+			continue
+		}
+
+		var deprecations []Deprecation
+		address := block.Labels[0] + "." + block.Labels[1]
+		switch block.Type {
+		case "resource":
+			deprecations = l.resources[block.Labels[0]]
+		case "data":
+			deprecations = l.dataSources[block.Labels[0]]
+			address = "data." + address
+		}
+
+		for _, d := range deprecations {
+			var ranges []hcl.Range
+			if d.Field == "" {
+				ranges = []hcl.Range{block.DefRange()}
+			} else {
+				ranges = findInBody(block.Body, strings.Split(d.Field, "."))
+			}
+			for _, r := range ranges {
+				findings = append(findings, Finding{
+					File:        r.Filename,
+					Line:        r.Start.Line,
+					Column:      r.Start.Column,
+					Address:     address,
+					Field:       d.Field,
+					Kind:        d.Kind,
+					Message:     d.Message,
+					Replacement: d.Replacement,
+				})
+			}
+		}
+	}
+	return findings, nil
+}
+
+// findInBody returns where path is set in body, whether its segments are
+// written as blocks, dynamic blocks or attributes.
+func findInBody(body *hclsyntax.Body, path []string) []hcl.Range {
+	name, rest := path[0], path[1:]
+
+	var ranges []hcl.Range
+	if attr, ok := body.Attributes[name]; ok {
+		if len(rest) == 0 {
+			ranges = append(ranges, attr.NameRange)
+		} else {
+			ranges = append(ranges, findInExpr(attr.Expr, rest)...)
+		}
+	}
+
+	for _, block := range body.Blocks {
+		switch {
+		case block.Type == name:
+			if len(rest) == 0 {
+				ranges = append(ranges, block.TypeRange)
+			} else {
+				ranges = append(ranges, findInBody(block.Body, rest)...)
+			}
+
+		case block.Type == "dynamic" && len(block.Labels) == 1 && block.Labels[0] == name:
+			if len(rest) == 0 {
+				ranges = append(ranges, block.LabelRanges[0])
+				continue
+			}
+			for _, content := range block.Body.Blocks {
+				if content.Type == "content" {
+					ranges = append(ranges, findInBody(content.Body, rest)...)
+				}
+			}
+		}
+	}
+	return ranges
+}
+
+// findInExpr returns where path is set in the object or list of objects
+// expr, the value of a nested attribute.
+func findInExpr(expr hclsyntax.Expression, path []string) []hcl.Range {
+	name, rest := path[0], path[1:]
+
+	var ranges []hcl.Range
+	switch e := expr.(type) {
+	case *hclsyntax.ParenthesesExpr:
+		return findInExpr(e.Expression, path)
+
+	case *hclsyntax.TupleConsExpr:
+		for _, elem := range e.Exprs {
+			ranges = append(ranges, findInExpr(elem, path)...)
+		}
+
+	case *hclsyntax.ObjectConsExpr:
+		for _, item := range e.Items {
+			if objectKey(item.KeyExpr) != name {
+				continue
+			}
+			if len(rest) == 0 {
+				ranges = append(ranges, item.KeyExpr.Range())
+			} else {
+				ranges = append(ranges, findInExpr(item.ValueExpr, rest)...)
+			}
+		}
+	}
+	return ranges
+}
+
+// objectKey returns the name of a literal object key, bare or quoted.
+func objectKey(expr hclsyntax.Expression) string {
+	if key := hcl.ExprAsKeyword(expr); key != "" {
+		return key
+	}
+	v, diags := expr.Value(nil)
+	if diags.HasErrors() || !v.IsKnown() || v.IsNull() || v.Type() != cty.String {
+		return ""
+	}
+	return v.AsString()
+}
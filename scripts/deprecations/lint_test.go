@@ -0,0 +1,72 @@
+package deprecations
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLintDirectory(t *testing.T) {
+	found, err := ScanDirectory(filepath.Join("testdata", "provider"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := NewLinter(found).LintDirectory(filepath.Join("testdata", "config"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := filepath.Join("testdata", "config", "main.tf")
+	type location struct {
+		Line    int
+		Address string
+		Field   string
+		Kind    string
+	}
+	want := []location{
+		{Line: 2, Address: "rafay_gadget.blocks", Field: "project_id", Kind: KindField},
+		{Line: 4, Address: "rafay_gadget.blocks", Field: "spec.tags", Kind: KindField},
+		{Line: 14, Address: "rafay_gadget.dynamic", Field: "spec.tags", Kind: KindField},
+		{Line: 21, Address: "rafay_widget.attributes", Field: "spec.node_groups", Kind: KindField},
+		{Line: 27, Address: "rafay_mesh_profile.removed", Kind: KindRemoved},
+		{Line: 30, Address: "data.rafay_old_gadgets.all", Kind: KindResource},
+		{Line: 33, Address: "data.rafay_mesh_profiles.all", Kind: KindRemoved},
+	}
+
+	var locations []location
+	for _, f := range got {
+		if f.File != file {
+			t.Errorf("finding in %s, want %s", f.File, file)
+		}
+		locations = append(locations, location{Line: f.Line, Address: f.Address, Field: f.Field, Kind: f.Kind})
+	}
+	if diff := cmp.Diff(want, locations); diff != "" {
+		t.Errorf("LintDirectory() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestLintFileInvalid(t *testing.T) {
+	_, diags := NewLinter(nil).LintFile("main.tf", []byte(`resource "rafay_gadget" {`))
+	if !diags.HasErrors() {
+		t.Fatal("expected a parse error")
+	}
+}
+
+func TestFindingString(t *testing.T) {
+	f := Finding{
+		File:        "main.tf",
+		Line:        4,
+		Column:      5,
+		Address:     "rafay_gadget.blocks",
+		Field:       "spec.tags",
+		Kind:        KindField,
+		Message:     "Use `labels` instead.",
+		Replacement: "labels",
+	}
+	want := "main.tf:4,5: rafay_gadget.blocks: spec.tags is deprecated: Use `labels` instead. (replace with labels)"
+	if got := f.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
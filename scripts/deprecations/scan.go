@@ -0,0 +1,312 @@
+// Package deprecations finds the deprecated and removed resources and
+// attributes of the provider in its Go source. The result feeds both the
+// changelog generator and the configuration linter.
+package deprecations
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Kinds of deprecation.
+const (
+	// KindField is a deprecated attribute or block of a resource.
+	KindField = "field"
+	// KindResource is a deprecated resource or data source.
+	KindResource = "resource"
+	// KindRemoved is a resource or data source no longer served by the
+	// provider, found commented out of its ResourcesMap or DataSourcesMap.
+	KindRemoved = "removed"
+)
+
+// Deprecation represents a detected deprecation in the code
+type Deprecation struct {
+	Resource string `json:"resource"`
+	// Field is the dot separated path of the attribute within the resource,
+	// e.g. cluster_config.managed_nodegroups.
+	Field       string `json:"field,omitempty"`
+	Kind        string `json:"kind,omitempty"`
+	DataSource  bool   `json:"data_source,omitempty"`
+	Message     string `json:"message"`
+	Replacement string `json:"replacement,omitempty"`
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+}
+
+// Result holds all detected deprecations
+type Result struct {
+	Deprecations []Deprecation `json:"deprecations"`
+}
+
+// ScanDirectory scans the Go files under root, except tests, test data and
+// vendored code, for deprecations. Files that fail to parse are passed to onError and
+// skipped.
+func ScanDirectory(root string, onError func(path string, err error)) ([]Deprecation, error) {
+	var deprecations []Deprecation
+	var removed []Deprecation
+	registered := map[string]bool{}
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		// Skip vendored files and test data
+		if info.IsDir() && path != root && (info.Name() == "vendor" || info.Name() == "testdata") {
+			return filepath.SkipDir
+		}
+
+		// Skip non-Go files and test files
+		if !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		fset := token.NewFileSet()
+		node, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			if onError != nil {
+				onError(path, err)
+			}
+			return nil // Continue scanning other files
+		}
+
+		deprecations = append(deprecations, ScanFile(fset, node, path)...)
+		removed = append(removed, scanRemoved(fset, node, path)...)
+		for name := range registeredNames(node) {
+			registered[name] = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A resource commented out of the SDKv2 provider may have been ported
+	// to the framework provider rather than removed.
+	for _, d := range removed {
+		if !registered[d.Resource] {
+			deprecations = append(deprecations, d)
+		}
+	}
+	return deprecations, nil
+}
+
+// ScanFile returns the deprecations of the schemas declared in a parsed
+// file. An SDKv2 `Deprecated` or framework `DeprecationMessage` is reported
+// at the path of map keys leading to it; at the top level of a schema it
+// deprecates the whole resource.
+func ScanFile(fset *token.FileSet, node *ast.File, filename string) []Deprecation {
+	resourceName := ResourceName(filename)
+	dataSource := isDataSourceFile(filename)
+
+	var deprecations []Deprecation
+	var visit func(n ast.Node, path []string)
+	visit = func(n ast.Node, path []string) {
+		ast.Inspect(n, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+
+			// Schema map entries, e.g. "metadata": {...}, nest the path.
+			if key, ok := stringLit(kv.Key); ok {
+				visit(kv.Value, append(append([]string{}, path...), key))
+				return false
+			}
+
+			ident, ok := kv.Key.(*ast.Ident)
+			if !ok || (ident.Name != "Deprecated" && ident.Name != "DeprecationMessage") {
+				return true
+			}
+			message, ok := stringLit(kv.Value)
+			if !ok || message == "" {
+				return true
+			}
+
+			d := Deprecation{
+				Resource:    resourceName,
+				Field:       strings.Join(path, "."),
+				Kind:        KindField,
+				DataSource:  dataSource,
+				Message:     message,
+				Replacement: Replacement(message),
+				File:        filename,
+				Line:        fset.Position(kv.Value.Pos()).Line,
+			}
+			if d.Field == "" {
+				d.Kind = KindResource
+			}
+			deprecations = append(deprecations, d)
+			return false
+		})
+	}
+	visit(node, nil)
+
+	return deprecations
+}
+
+var removedEntryRegex = regexp.MustCompile(`^//\s*"(rafay_[a-z0-9_]+)"\s*:`)
+
+// scanRemoved returns the entries commented out of the ResourcesMap and
+// DataSourcesMap of an SDKv2 provider.
+func scanRemoved(fset *token.FileSet, node *ast.File, filename string) []Deprecation {
+	var removed []Deprecation
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		kv, ok := n.(*ast.KeyValueExpr)
+		if !ok {
+			return true
+		}
+		ident, ok := kv.Key.(*ast.Ident)
+		if !ok || (ident.Name != "ResourcesMap" && ident.Name != "DataSourcesMap") {
+			return true
+		}
+
+		dataSource := ident.Name == "DataSourcesMap"
+		for _, group := range node.Comments {
+			if group.Pos() < kv.Value.Pos() || group.End() > kv.Value.End() {
+				continue
+			}
+			for _, c := range group.List {
+				m := removedEntryRegex.FindStringSubmatch(c.Text)
+				if m == nil {
+					continue
+				}
+				kind := "resource"
+				if dataSource {
+					kind = "data source"
+				}
+				removed = append(removed, Deprecation{
+					Resource:   m[1],
+					Kind:       KindRemoved,
+					DataSource: dataSource,
+					Message:    "The " + kind + " has been removed from the provider.",
+					File:       filename,
+					Line:       fset.Position(c.Pos()).Line,
+				})
+			}
+		}
+		return false
+	})
+
+	return removed
+}
+
+// registeredNames returns the resource and data source type names a file
+// registers: the keys of an SDKv2 ResourcesMap or DataSourcesMap, and the
+// `req.ProviderTypeName + "_name"` of a framework Metadata method.
+func registeredNames(node *ast.File) map[string]bool {
+	names := map[string]bool{}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch x := n.(type) {
+		case *ast.KeyValueExpr:
+			ident, ok := x.Key.(*ast.Ident)
+			if !ok || (ident.Name != "ResourcesMap" && ident.Name != "DataSourcesMap") {
+				return true
+			}
+			comp, ok := x.Value.(*ast.CompositeLit)
+			if !ok {
+				return true
+			}
+			for _, elt := range comp.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if key, ok := stringLit(kv.Key); ok {
+						names[key] = true
+					}
+				}
+			}
+			return false
+
+		case *ast.BinaryExpr:
+			sel, ok := x.X.(*ast.SelectorExpr)
+			if !ok || x.Op != token.ADD || sel.Sel.Name != "ProviderTypeName" {
+				return true
+			}
+			if suffix, ok := stringLit(x.Y); ok {
+				names["rafay"+suffix] = true
+			}
+		}
+		return true
+	})
+
+	return names
+}
+
+func stringLit(e ast.Expr) (string, bool) {
+	lit, ok := e.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	s, err := strconv.Unquote(lit.Value)
+	if err != nil {
+		return "", false
+	}
+	return s, true
+}
+
+var replacementRegexes = []*regexp.Regexp{
+	regexp.MustCompile("(?i)\\buse ['`\"]([a-z0-9_.]+)['`\"]"),
+	regexp.MustCompile("(?i)\\buse (?:the )?(rafay_[a-z0-9_]+)"),
+	regexp.MustCompile("(?i)\\b(?:use|configure) ([a-z0-9_.]+)(?: block| attribute| resource)? instead"),
+	regexp.MustCompile("(?i)\\breplaced by ['`\"]?([a-z0-9_.]+)"),
+}
+
+// Replacement returns the attribute or resource a deprecation message points
+// to, e.g. `managed_nodegroups_map` for "Use 'managed_nodegroups_map' for new
+// configs", or "" when it names none.
+func Replacement(message string) string {
+	for _, re := range replacementRegexes {
+		if m := re.FindStringSubmatch(message); m != nil {
+			return m[1]
+		}
+	}
+	return ""
+}
+
+// ResourceName derives the Terraform type name of the resource declared in
+// a file from its path, e.g.
+//
+//	rafay/resource_eks_cluster.go -> rafay_eks_cluster
+//	rafay/data_source_clusters.go -> rafay_clusters
+//	rafay/data_rafay_namespaces.go -> rafay_namespaces
+//	internal/resource_eks_cluster/eks_cluster_resource_gen.go -> rafay_eks_cluster
+func ResourceName(filename string) string {
+	// Framework schemas live in a package per resource
+	dir := filepath.Base(filepath.Dir(filename))
+	for _, prefix := range []string{"resource_", "datasource_"} {
+		if strings.HasPrefix(dir, prefix) {
+			return "rafay_" + strings.TrimPrefix(dir, prefix)
+		}
+	}
+
+	base := filepath.Base(filename)
+	base = strings.TrimSuffix(base, ".go")
+
+	// Remove common prefixes
+	for _, prefix := range []string{"resource_", "data_source_", "data_rafay_", "data_"} {
+		if strings.HasPrefix(base, prefix) {
+			base = strings.TrimPrefix(base, prefix)
+			break
+		}
+	}
+
+	// Construct resource name
+	if dir == "rafay" || dir == "provider" {
+		return "rafay_" + base
+	}
+
+	return base
+}
+
+func isDataSourceFile(filename string) bool {
+	base := filepath.Base(filename)
+	dir := filepath.Base(filepath.Dir(filename))
+	return strings.HasPrefix(base, "data_") || strings.HasPrefix(dir, "datasource_")
+}
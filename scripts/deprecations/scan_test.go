@@ -0,0 +1,116 @@
+package deprecations
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestScanDirectory(t *testing.T) {
+	root := filepath.Join("testdata", "provider")
+	got, err := ScanDirectory(root, func(path string, err error) {
+		t.Errorf("unexpected error scanning %s: %v", path, err)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Deprecation{
+		{
+			Resource: "rafay_widget",
+			Field:    "spec.node_groups",
+			Kind:     KindField,
+			Message:  "The 'node_groups' attribute is deprecated. Use 'node_groups_map' for new configs.",
+			File:     filepath.Join(root, "internal", "resource_widget", "widget_resource_gen.go"),
+			Line:     9,
+
+			Replacement: "node_groups_map",
+		},
+		{
+			Resource:    "rafay_old_gadgets",
+			Kind:        KindResource,
+			DataSource:  true,
+			Message:     "Use the rafay_gadgets data source instead.",
+			Replacement: "rafay_gadgets",
+			File:        filepath.Join(root, "rafay", "data_old_gadgets.go"),
+			Line:        5,
+		},
+		{
+			Resource:    "rafay_gadget",
+			Field:       "project_id",
+			Kind:        KindField,
+			Message:     "Configure metadata.project instead.",
+			Replacement: "metadata.project",
+			File:        filepath.Join(root, "rafay", "resource_gadget.go"),
+			Line:        9,
+		},
+		{
+			Resource:    "rafay_gadget",
+			Field:       "spec.tags",
+			Kind:        KindField,
+			Message:     "Use `labels` instead.",
+			Replacement: "labels",
+			File:        filepath.Join(root, "rafay", "resource_gadget.go"),
+			Line:        19,
+		},
+		{
+			Resource: "rafay_mesh_profile",
+			Kind:     KindRemoved,
+			Message:  "The resource has been removed from the provider.",
+			File:     filepath.Join(root, "rafay", "provider.go"),
+			Line:     7,
+		},
+		{
+			Resource:   "rafay_mesh_profiles",
+			Kind:       KindRemoved,
+			DataSource: true,
+			Message:    "The data source has been removed from the provider.",
+			File:       filepath.Join(root, "rafay", "provider.go"),
+			Line:       11,
+		},
+	}
+
+	sortByLocation := cmpopts.SortSlices(func(a, b Deprecation) bool {
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+	if diff := cmp.Diff(want, got, sortByLocation); diff != "" {
+		t.Errorf("ScanDirectory() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestReplacement(t *testing.T) {
+	cases := map[string]string{
+		"Use 'managed_nodegroups_map' for new configs.":          "managed_nodegroups_map",
+		"Configure resource_tags block instead.":                 "resource_tags",
+		"This attribute has been replaced by `spec.labels`.":     "spec.labels",
+		"Deprecated, will be removed in the next major release.": "",
+		"Values in use by clusters are kept.":                    "",
+	}
+
+	for message, want := range cases {
+		if got := Replacement(message); got != want {
+			t.Errorf("Replacement(%q) = %q, want %q", message, got, want)
+		}
+	}
+}
+
+func TestResourceName(t *testing.T) {
+	cases := map[string]string{
+		"rafay/resource_eks_cluster.go":                             "rafay_eks_cluster",
+		"rafay/data_source_clusters.go":                             "rafay_clusters",
+		"rafay/data_rafay_namespaces.go":                            "rafay_namespaces",
+		"rafay/data_addon.go":                                       "rafay_addon",
+		"internal/resource_eks_cluster/eks_cluster_resource_gen.go": "rafay_eks_cluster",
+	}
+
+	for filename, want := range cases {
+		if got := ResourceName(filename); got != want {
+			t.Errorf("ResourceName(%q) = %q, want %q", filename, got, want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+resource "rafay_gadget" "blocks" {
+  project_id = "terraform"
+  spec {
+    tags = {
+      env = "prod"
+    }
+  }
+}
+
+resource "rafay_gadget" "dynamic" {
+  dynamic "spec" {
+    for_each = ["a"]
+    content {
+      tags = {}
+    }
+  }
+}
+
+resource "rafay_widget" "attributes" {
+  spec = {
+    "node_groups" = [{
+      name = "ng"
+    }]
+  }
+}
+
+resource "rafay_mesh_profile" "removed" {
+}
+
+data "rafay_old_gadgets" "all" {
+}
+
+data "rafay_mesh_profiles" "all" {
+}
@@ -0,0 +1,15 @@
+package resource_widget
+
+func WidgetResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"spec": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"node_groups": schema.ListNestedAttribute{
+						DeprecationMessage: "The 'node_groups' attribute is deprecated. Use 'node_groups_map' for new configs.",
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,8 @@
+package rafay
+
+func dataOldGadgets() *schema.Resource {
+	return &schema.Resource{
+		DeprecationMessage: "Use the rafay_gadgets data source instead.",
+		Schema:             map[string]*schema.Schema{},
+	}
+}
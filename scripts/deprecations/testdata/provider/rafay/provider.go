@@ -0,0 +1,14 @@
+package rafay
+
+func New() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"rafay_gadget": resourceGadget(),
+			//"rafay_mesh_profile":  resourceMeshProfile(),
+			//"rafay_widget":        resourceWidget(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			//"rafay_mesh_profiles": dataMeshProfiles(),
+		},
+	}
+}
@@ -0,0 +1,26 @@
+package rafay
+
+func resourceGadget() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:       schema.TypeString,
+				Optional:   true,
+				Deprecated: "Configure metadata.project instead.",
+			},
+			"spec": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:       schema.TypeMap,
+							Optional:   true,
+							Deprecated: "Use `labels` instead.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+// Command lint-config reports the uses of deprecated and removed resources,
+// data sources and attributes of the provider in Terraform configurations.
+//
+// The deprecations are scanned from the provider source, or read from the
+// JSON written by scan-deprecations:
+//
+//	go run ./scripts/lint-config -provider . ../infra ../modules
+//	go run ./scripts/lint-config -deprecations deprecations.json ../infra
+//
+// A deprecations file only lists the removed resources when scan-deprecations
+// wrote it with -include-removed.
+//
+// It exits with status 1 when anything is found, so it can gate an upgrade
+// of the provider in CI, and with status 2 on errors.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/RafaySystems/terraform-provider-rafay/scripts/deprecations"
+)
+
+var (
+	providerFlag     = flag.String("provider", ".", "Path of the provider source to scan for deprecations")
+	deprecationsFlag = flag.String("deprecations", "", "JSON file written by scan-deprecations -include-removed, used instead of scanning -provider")
+	formatFlag       = flag.String("format", "text", "Output format, text or json")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [config directory ...]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *formatFlag != "text" && *formatFlag != "json" {
+		fmt.Fprintf(os.Stderr, "Error: unknown format %q, expected text or json\n", *formatFlag)
+		os.Exit(2)
+	}
+
+	found, err := loadDeprecations()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading deprecations: %v\n", err)
+		os.Exit(2)
+	}
+
+	dirs := flag.Args()
+	if len(dirs) == 0 {
+		dirs = []string{"."}
+	}
+
+	linter := deprecations.NewLinter(found)
+	findings := []deprecations.Finding{}
+	for _, dir := range dirs {
+		f, err := linter.LintDirectory(dir)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error linting %s: %v\n", dir, err)
+			os.Exit(2)
+		}
+		findings = append(findings, f...)
+	}
+
+	switch *formatFlag {
+	case "json":
+		data, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error marshaling JSON: %v\n", err)
+			os.Exit(2)
+		}
+		fmt.Println(string(data))
+	default:
+		for _, f := range findings {
+			fmt.Println(f)
+		}
+	}
+
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+func loadDeprecations() ([]deprecations.Deprecation, error) {
+	if *deprecationsFlag == "" {
+		return deprecations.ScanDirectory(*providerFlag, nil)
+	}
+
+	data, err := os.ReadFile(*deprecationsFlag)
+	if err != nil {
+		return nil, err
+	}
+	var result deprecations.Result
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("%s: %w", *deprecationsFlag, err)
+	}
+	return result.Deprecations, nil
+}
@@ -0,0 +1,60 @@
+package offline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const addonConfig = `
+resource "rafay_addon" "test" {
+  metadata {
+    name    = %q
+    project = %q
+  }
+  spec {
+    namespace = "offline-ns"
+    version   = "v1"
+    artifact {
+      type = "Helm"
+      artifact {
+        repository    = "offline-repo"
+        chart_name    = "busybox"
+        chart_version = "1.0.0"
+      }
+    }
+  }
+}
+`
+
+// TestAddonOffline runs a full apply, refresh, import and destroy cycle of
+// rafay_addon against the in-process fake hub.
+func TestAddonOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	addonKey := fakehub.Key{Group: "infra.k8smgmt.io", Version: "v3", Project: fakehub.DefaultProject, Plural: "addons", Name: "offline-addon"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, addonKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(addonConfig, addonKey.Name, addonKey.Project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_addon.test", "id", addonKey.Name),
+					checkApplied(hub, addonKey),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "rafay_addon.test",
+				ImportState:       true,
+				ImportStateId:     addonKey.Name + "/" + addonKey.Project,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
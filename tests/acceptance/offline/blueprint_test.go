@@ -0,0 +1,55 @@
+package offline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const blueprintConfig = `
+resource "rafay_blueprint" "test" {
+  metadata {
+    name    = %q
+    project = %q
+  }
+  spec {
+    version = "v1"
+    base {
+      name    = "minimal"
+      version = "2.0.0"
+    }
+  }
+}
+`
+
+// TestBlueprintOffline runs a full apply, refresh, import and destroy cycle
+// of rafay_blueprint against the in-process fake hub.
+func TestBlueprintOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	bpKey := fakehub.Key{Group: "infra.k8smgmt.io", Version: "v3", Project: fakehub.DefaultProject, Plural: "blueprints", Name: "offline-bp"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, bpKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(blueprintConfig, bpKey.Name, bpKey.Project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_blueprint.test", "id", bpKey.Name),
+					checkApplied(hub, bpKey),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "rafay_blueprint.test",
+				ImportState:       true,
+				ImportStateId:     bpKey.Name + "/" + bpKey.Project,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,146 @@
+package offline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const importClusterConfig = `
+resource "rafay_import_cluster" "test" {
+  clustername = %q
+  projectname = %q
+  blueprint   = "default"
+  write_files = false
+  labels = {
+    env = "offline"
+  }
+}
+`
+
+// TestImportClusterOffline runs a full apply, refresh, import and destroy
+// cycle of rafay_import_cluster against the in-process fake hub. The
+// resource registers the cluster through the legacy edge APIs only.
+func TestImportClusterOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	name, project := "offline-imported", fakehub.DefaultProject
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy: func(*terraform.State) error {
+			if _, ok := hub.Edge(project, name); ok {
+				return fmt.Errorf("cluster %s still exists", name)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(importClusterConfig, name, project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_import_cluster.test", "id", fakehub.ClusterID(name)),
+					resource.TestCheckResourceAttr("rafay_import_cluster.test", "labels.env", "offline"),
+					resource.TestCheckResourceAttrSet("rafay_import_cluster.test", "bootstrap_data"),
+					resource.TestCheckResourceAttrSet("rafay_import_cluster.test", "values_data"),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "rafay_import_cluster.test",
+				ImportState:       true,
+				ImportStateId:     name + "/" + project,
+				ImportStateVerify: true,
+				// write_files only controls what the create writes locally,
+				// and the bootstrap and values files are downloaded for the
+				// create; none of them is kept by the console.
+				ImportStateVerifyIgnore: []string{"write_files", "bootstrap_data", "values_data"},
+			},
+		},
+	})
+}
+
+const mksClusterConfig = `
+resource "rafay_mks_cluster" "test" {
+  api_version = "infra.k8smgmt.io/v3"
+  kind        = "Cluster"
+  metadata = {
+    name    = %q
+    project = %q
+  }
+  spec = {
+    blueprint = {
+      name = "minimal"
+    }
+    cloud_credentials = "offline-ssh-creds"
+    config = {
+      auto_approve_nodes      = true
+      dedicated_control_plane = false
+      high_availability       = false
+      kubernetes_version      = "v1.28.9"
+      network = {
+        cni = {
+          name    = "Calico"
+          version = "3.26.1"
+        }
+        pod_subnet     = "10.244.0.0/16"
+        service_subnet = "10.96.0.0/12"
+      }
+      nodes = {
+        "offline-node" = {
+          arch             = "amd64"
+          hostname         = "offline-node"
+          operating_system = "Ubuntu22.04"
+          private_ip       = "10.12.1.148"
+          roles            = ["ControlPlane", "Worker"]
+        }
+      }
+    }
+    type = "mks"
+  }
+  timeouts {
+    create = "5m"
+    update = "5m"
+    delete = "5m"
+  }
+}
+`
+
+// TestMksClusterOffline runs a full apply, refresh and destroy cycle of the
+// framework rafay_mks_cluster against the in-process fake hub. It has no
+// import step: the resource has no id attribute for the SDK test helper to
+// import by. The fake reports every applied cluster as provisioned; the
+// resource reads the sharing settings from the edge the fake derives from the
+// v3 cluster.
+func TestMksClusterOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	hub.OnApply = func(key fakehub.Key, obj map[string]interface{}) {
+		if key.Plural == "clusters" {
+			obj["status"] = map[string]interface{}{
+				"commonStatus": map[string]interface{}{"conditionStatus": "StatusOK"},
+				"mks":          map[string]interface{}{},
+			}
+		}
+	}
+	clusterKey := fakehub.Key{Group: "infra.k8smgmt.io", Version: "v3", Project: fakehub.DefaultProject, Plural: "clusters", Name: "offline-mks"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, clusterKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(mksClusterConfig, clusterKey.Name, clusterKey.Project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_mks_cluster.test", "metadata.name", clusterKey.Name),
+					checkApplied(hub, clusterKey),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package offline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const environmentConfig = `
+resource "rafay_environment" "test" {
+  metadata {
+    name    = %q
+    project = %q
+  }
+  spec {
+    template {
+      name    = "offline-template"
+      version = "v1"
+    }
+  }
+}
+`
+
+// TestEnvironmentOffline runs a full apply, refresh, import and destroy
+// cycle of rafay_environment against the in-process fake hub.
+func TestEnvironmentOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	envKey := fakehub.Key{Group: "eaas.envmgmt.io", Version: "v1", Project: fakehub.DefaultProject, Plural: "environments", Name: "offline-env"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, envKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(environmentConfig, envKey.Name, envKey.Project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_environment.test", "id", envKey.Name),
+					checkApplied(hub, envKey),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "rafay_environment.test",
+				ImportState:       true,
+				ImportStateId:     envKey.Name + "/" + envKey.Project,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+package offline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const namespaceConfig = `
+resource "rafay_namespace" "test" {
+  metadata = {
+    name    = %q
+    project = %q
+  }
+  spec = {
+    drift = {
+      enabled = false
+    }
+  }
+}
+`
+
+// TestNamespaceOffline runs a full apply, refresh, import and destroy cycle
+// of rafay_namespace against the in-process fake hub.
+func TestNamespaceOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	nsKey := fakehub.Key{Group: "infra.k8smgmt.io", Version: "v3", Project: fakehub.DefaultProject, Plural: "namespaces", Name: "offline-ns"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, nsKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(namespaceConfig, nsKey.Name, nsKey.Project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_namespace.test", "id", nsKey.Name),
+					checkApplied(hub, nsKey),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "rafay_namespace.test",
+				ImportState:       true,
+				ImportStateId:     nsKey.Name + "/" + nsKey.Project,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
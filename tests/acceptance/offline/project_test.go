@@ -0,0 +1,52 @@
+package offline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const projectConfig = `
+resource "rafay_project" "test" {
+  metadata {
+    name        = %q
+    description = "offline project"
+  }
+  spec {
+    default = false
+  }
+}
+`
+
+// TestProjectOffline runs a full apply, refresh, import and destroy cycle
+// of rafay_project against the in-process fake hub. The project is deleted
+// by ID through the legacy API.
+func TestProjectOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	projectKey := fakehub.Key{Group: "system.k8smgmt.io", Version: "v3", Plural: "projects", Name: "offline-project"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, projectKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(projectConfig, projectKey.Name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_project.test", "id", projectKey.Name),
+					checkApplied(hub, projectKey),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "rafay_project.test",
+				ImportState:       true,
+				ImportStateId:     projectKey.Name,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
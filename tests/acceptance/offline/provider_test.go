@@ -0,0 +1,46 @@
+package offline_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/RafaySystems/terraform-provider-rafay/internal/provider"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// providerFactories serves the muxed provider, as main does, so resources of
+// both the framework and the SDKv2 provider are available.
+func providerFactories() map[string]func() (tfprotov6.ProviderServer, error) {
+	return map[string]func() (tfprotov6.ProviderServer, error){
+		"rafay": func() (tfprotov6.ProviderServer, error) {
+			muxServer, err := provider.NewMuxServer(context.Background(), "test")
+			if err != nil {
+				return nil, err
+			}
+			return muxServer.ProviderServer(), nil
+		},
+	}
+}
+
+// checkApplied checks the fake hub stores the object under key.
+func checkApplied(hub *fakehub.Server, key fakehub.Key) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, ok := hub.Get(key); !ok {
+			return fmt.Errorf("%s was not applied", key)
+		}
+		return nil
+	}
+}
+
+// checkDeleted checks the fake hub no longer stores the object under key.
+func checkDeleted(hub *fakehub.Server, key fakehub.Key) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		if _, ok := hub.Get(key); ok {
+			return fmt.Errorf("%s still exists", key)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,44 @@
+package offline_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/internal/transport"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// TestTypedClientRetriesOffline checks that requests of the typed hub client
+// go through the shared retry transport: the first read of the blueprint
+// fails with a 503 and the apply still succeeds.
+func TestTypedClientRetriesOffline(t *testing.T) {
+	t.Setenv(transport.EnvMaxRetries, "2")
+	t.Setenv(transport.EnvRetryMaxWait, "100ms")
+
+	hub := fakehub.NewServer(t)
+	bpKey := fakehub.Key{Group: "infra.k8smgmt.io", Version: "v3", Project: fakehub.DefaultProject, Plural: "blueprints", Name: "offline-retry"}
+	path := "/apis/infra.k8smgmt.io/v3/projects/" + bpKey.Project + "/blueprints/" + bpKey.Name
+	hub.Fail(http.MethodGet, path, 1, http.StatusServiceUnavailable)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, bpKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(blueprintConfig, bpKey.Name, bpKey.Project),
+				Check: resource.ComposeTestCheckFunc(
+					checkApplied(hub, bpKey),
+					func(*terraform.State) error {
+						if n := hub.PendingFailures(http.MethodGet, path); n != 0 {
+							return fmt.Errorf("the failing read of %s was not sent", path)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package offline_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const workloadConfig = `
+resource "rafay_workload" "test" {
+  metadata {
+    name    = %q
+    project = %q
+  }
+  spec {
+    namespace = "offline-ns"
+    version   = "v1"
+    placement {
+      selector = "rafay.dev/clusterName=offline-cluster"
+    }
+    artifact {
+      type = "Helm"
+      artifact {
+        repository    = "offline-repo"
+        chart_name    = "busybox"
+        chart_version = "1.0.0"
+      }
+    }
+  }
+}
+`
+
+// TestWorkloadOffline runs a full apply, refresh, import and destroy cycle
+// of rafay_workload against the in-process fake hub. The fake accepts the
+// publish right away, so the create waits for a single status poll.
+func TestWorkloadOffline(t *testing.T) {
+	hub := fakehub.NewServer(t)
+	wlKey := fakehub.Key{Group: "apps.k8smgmt.io", Version: "v3", Project: fakehub.DefaultProject, Plural: "workloads", Name: "offline-wl"}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: providerFactories(),
+		CheckDestroy:             checkDeleted(hub, wlKey),
+		Steps: []resource.TestStep{
+			{
+				Config: hub.ProviderConfig(t) + fmt.Sprintf(workloadConfig, wlKey.Name, wlKey.Project),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_workload.test", "id", wlKey.Name),
+					checkApplied(hub, wlKey),
+				),
+			},
+			{
+				RefreshState: true,
+			},
+			{
+				ResourceName:      "rafay_workload.test",
+				ImportState:       true,
+				ImportStateId:     wlKey.Name + "/" + wlKey.Project,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,432 @@
+package fakehub
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// Legacy rctl APIs.
+//
+// Some SDK resources, and the rctl helpers they call, still use the APIs
+// that predate the v3 layout:
+//
+//	/auth/v1/projects/[{project id}/]
+//	/edge/v1/projects/{project id}/edges/[{cluster id or name}/[...]]
+//	/v2/scheduler/project/{project id}/cluster[/{name}[/download/{file}]]
+//	/v2/config/project/{project id}/workload/{name}[/{action}]
+//
+// They address projects, and some of them clusters, by ID. The fake derives
+// the IDs from the names, see ProjectID and ClusterID, and serves these APIs
+// from the objects of the v3 layout: a project applied as a v3 object can be
+// looked up and deleted by ID, and a v3 cluster has an edge. Clusters created
+// through the legacy APIs, as imported clusters are, are kept as edges only.
+
+const (
+	projectIDPrefix = "project-"
+	clusterIDPrefix = "cluster-"
+)
+
+// ProjectID is the ID the legacy APIs know the project name by.
+func ProjectID(name string) string {
+	return projectIDPrefix + name
+}
+
+// ClusterID is the ID the legacy APIs know the cluster name by.
+func ClusterID(name string) string {
+	return clusterIDPrefix + name
+}
+
+func projectKey(name string) Key {
+	return Key{Group: "system.k8smgmt.io", Version: "v3", Plural: "projects", Name: name}
+}
+
+func clusterKey(project, name string) Key {
+	return Key{Group: "infra.k8smgmt.io", Version: "v3", Project: project, Plural: "clusters", Name: name}
+}
+
+func workloadKey(project, name string) Key {
+	return Key{Group: "apps.k8smgmt.io", Version: "v3", Project: project, Plural: "workloads", Name: name}
+}
+
+// edgeKey addresses the edge of a cluster. It is only stored once the edge
+// is created or updated through the legacy APIs; until then it is derived
+// from the v3 cluster.
+func edgeKey(project, name string) Key {
+	return Key{Group: "edge", Version: "v1", Project: project, Plural: "edges", Name: name}
+}
+
+// Edge returns the edge of the cluster name in project, as the legacy APIs
+// serve it.
+func (s *Server) Edge(project, name string) (map[string]interface{}, bool) {
+	if edge, ok := s.Get(edgeKey(project, name)); ok {
+		return edge, true
+	}
+	c, ok := s.Get(clusterKey(project, name))
+	if !ok {
+		return nil, false
+	}
+	edge := newEdge(project, name)
+	spec, _ := c["spec"].(map[string]interface{})
+	if t, ok := spec["type"].(string); ok {
+		edge["cluster_type"] = t
+	}
+	if bp, ok := spec["blueprint"].(map[string]interface{}); ok {
+		edge["cluster_blueprint"] = bp["name"]
+		edge["cluster_blueprint_version"] = bp["version"]
+	}
+	if meta, ok := c["metadata"].(map[string]interface{}); ok && meta["labels"] != nil {
+		edge["labels"] = meta["labels"]
+	}
+	return edge, true
+}
+
+func newEdge(project, name string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":         ClusterID(name),
+		"name":       name,
+		"project_id": ProjectID(project),
+		"settings":   map[string]interface{}{},
+		"labels":     map[string]interface{}{},
+	}
+}
+
+// serveLegacy serves r if it is a request to one of the legacy APIs.
+func (s *Server) serveLegacy(w http.ResponseWriter, r *http.Request) bool {
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case len(parts) >= 3 && parts[0] == "auth" && parts[1] == "v1" && parts[2] == "projects":
+		s.legacyProject(w, r, parts[3:])
+	case len(parts) >= 5 && parts[0] == "edge" && parts[1] == "v1" && parts[2] == "projects" && parts[4] == "edges":
+		s.legacyEdge(w, r, parts[3], parts[5:])
+	case len(parts) >= 5 && parts[0] == "v2" && parts[1] == "scheduler" && parts[2] == "project" && parts[4] == "cluster":
+		s.legacyCluster(w, r, parts[3], parts[5:])
+	case len(parts) >= 6 && parts[0] == "v2" && parts[1] == "config" && parts[2] == "project" && parts[4] == "workload":
+		s.legacyWorkload(w, r, parts[3], parts[5], parts[6:])
+	default:
+		return false
+	}
+	return true
+}
+
+// projectName returns the name of the project with the legacy ID id.
+func (s *Server) projectName(id string) (string, bool) {
+	name, ok := strings.CutPrefix(id, projectIDPrefix)
+	if !ok {
+		return "", false
+	}
+	if _, ok := s.Get(projectKey(name)); !ok {
+		return "", false
+	}
+	return name, true
+}
+
+func (s *Server) legacyProject(w http.ResponseWriter, r *http.Request, rest []string) {
+	if len(rest) == 0 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusMethodNotAllowed, r.Method+" not allowed")
+			return
+		}
+		name := r.URL.Query().Get("name")
+		results := []interface{}{}
+		for _, obj := range s.List(projectKey("")) {
+			p := legacyProjectView(obj)
+			if name == "" || p["name"] == name {
+				results = append(results, p)
+			}
+		}
+		writeResults(w, results)
+		return
+	}
+
+	name, ok := s.projectName(rest[0])
+	if !ok || len(rest) > 1 {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", rest[0]))
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		obj, _ := s.Get(projectKey(name))
+		writeJSON(w, http.StatusOK, legacyProjectView(obj))
+	case http.MethodDelete:
+		s.delete(w, projectKey(name))
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method+" not allowed")
+	}
+}
+
+func legacyProjectView(obj map[string]interface{}) map[string]interface{} {
+	meta, _ := obj["metadata"].(map[string]interface{})
+	spec, _ := obj["spec"].(map[string]interface{})
+	name, _ := meta["name"].(string)
+	return map[string]interface{}{
+		"id":          ProjectID(name),
+		"name":        name,
+		"description": meta["description"],
+		"default":     spec["default"] == true,
+	}
+}
+
+func (s *Server) legacyEdge(w http.ResponseWriter, r *http.Request, projectID string, rest []string) {
+	project, ok := s.projectName(projectID)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectID))
+		return
+	}
+
+	if len(rest) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			name := r.URL.Query().Get("name")
+			results := []interface{}{}
+			for _, edge := range s.edges(project) {
+				if name == "" || edge["name"] == name {
+					results = append(results, edge)
+				}
+			}
+			writeResults(w, results)
+		case http.MethodPost:
+			s.createEdge(w, r, project)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, r.Method+" not allowed")
+		}
+		return
+	}
+
+	name, ok := s.edgeName(project, rest[0])
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", rest[0]))
+		return
+	}
+	edge, _ := s.Edge(project, name)
+	edge = copyObject(edge)
+
+	switch {
+	case len(rest) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, edge)
+	case len(rest) == 1 && r.Method == http.MethodPut:
+		update := map[string]interface{}{}
+		if !decodeBody(w, r, &update) {
+			return
+		}
+		for k, v := range update {
+			edge[k] = v
+		}
+		s.storeEdge(w, project, name, edge)
+	case len(rest) == 1 && r.Method == http.MethodDelete:
+		s.mu.Lock()
+		delete(s.objects, edgeKey(project, name).String())
+		delete(s.objects, clusterKey(project, name).String())
+		s.mu.Unlock()
+		writeJSON(w, http.StatusOK, map[string]interface{}{})
+	case len(rest) == 2 && rest[1] == "labels" && r.Method == http.MethodPut:
+		labels := map[string]interface{}{}
+		if !decodeBody(w, r, &labels) {
+			return
+		}
+		edge["labels"] = labels
+		s.storeEdge(w, project, name, edge)
+	case r.Method == http.MethodPost || r.Method == http.MethodPut:
+		// Actions such as a blueprint publish; there is nothing to roll out.
+		writeJSON(w, http.StatusOK, edge)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", r.Method, r.URL.Path))
+	}
+}
+
+// edges returns the edges of project, ordered by name.
+func (s *Server) edges(project string) []map[string]interface{} {
+	seen := map[string]bool{}
+	names := []string{}
+	for _, obj := range append(s.List(edgeKey(project, "")), s.List(clusterKey(project, ""))...) {
+		name, _ := obj["name"].(string)
+		if meta, ok := obj["metadata"].(map[string]interface{}); ok {
+			name, _ = meta["name"].(string)
+		}
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	edges := []map[string]interface{}{}
+	for _, name := range names {
+		if edge, ok := s.Edge(project, name); ok {
+			edges = append(edges, edge)
+		}
+	}
+	return edges
+}
+
+// edgeName returns the name of the cluster of project with the ID or name
+// idOrName.
+func (s *Server) edgeName(project, idOrName string) (string, bool) {
+	if _, ok := s.Edge(project, idOrName); ok {
+		return idOrName, true
+	}
+	if name, ok := strings.CutPrefix(idOrName, clusterIDPrefix); ok {
+		if _, ok := s.Edge(project, name); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// createEdge registers a cluster through the legacy APIs, from either an
+// edge or a v2 cluster with the name in its metadata.
+func (s *Server) createEdge(w http.ResponseWriter, r *http.Request, project string) {
+	body := map[string]interface{}{}
+	if !decodeBody(w, r, &body) {
+		return
+	}
+	name, _ := body["name"].(string)
+	if meta, ok := body["metadata"].(map[string]interface{}); ok && name == "" {
+		name, _ = meta["name"].(string)
+	}
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	if _, ok := s.Edge(project, name); ok {
+		writeError(w, http.StatusConflict, fmt.Sprintf("cluster %s already exists", name))
+		return
+	}
+
+	edge := newEdge(project, name)
+	for k, v := range body {
+		if _, ok := edge[k]; !ok {
+			edge[k] = v
+		}
+	}
+	if meta, ok := body["metadata"].(map[string]interface{}); ok && meta["labels"] != nil {
+		edge["labels"] = meta["labels"]
+	}
+	if labels, ok := body["labels"].(map[string]interface{}); ok {
+		edge["labels"] = labels
+	}
+	s.storeEdge(w, project, name, edge)
+}
+
+// storeEdge stores edge, keeping the ID, name and project it is served
+// under.
+func (s *Server) storeEdge(w http.ResponseWriter, project, name string, edge map[string]interface{}) {
+	edge["id"] = ClusterID(name)
+	edge["name"] = name
+	edge["project_id"] = ProjectID(project)
+	if edge["settings"] == nil {
+		edge["settings"] = map[string]interface{}{}
+	}
+	s.Seed(edgeKey(project, name), edge)
+	writeJSON(w, http.StatusOK, edge)
+}
+
+func (s *Server) legacyCluster(w http.ResponseWriter, r *http.Request, projectID string, rest []string) {
+	project, ok := s.projectName(projectID)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectID))
+		return
+	}
+	if len(rest) == 0 {
+		if r.Method != http.MethodPost {
+			writeError(w, http.StatusMethodNotAllowed, r.Method+" not allowed")
+			return
+		}
+		s.createEdge(w, r, project)
+		return
+	}
+
+	name := rest[0]
+	edge, ok := s.Edge(project, name)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("cluster %s not found", name))
+		return
+	}
+
+	switch {
+	case len(rest) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":    name,
+				"project": project,
+				"id":      edge["id"],
+				"labels":  edge["labels"],
+			},
+			"spec": map[string]interface{}{
+				"clusterType": edge["cluster_type"],
+				"blueprint":   edge["cluster_blueprint"],
+			},
+		})
+	case len(rest) == 3 && rest[1] == "download" && r.Method == http.MethodGet:
+		// Bootstrap and values files; their content is opaque to the
+		// resources.
+		data := fmt.Sprintf("# %s of cluster %s\n", rest[2], name)
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"data": base64.StdEncoding.EncodeToString([]byte(data)),
+		})
+	case r.Method == http.MethodPost || r.Method == http.MethodPut:
+		writeJSON(w, http.StatusOK, edge)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", r.Method, r.URL.Path))
+	}
+}
+
+func (s *Server) legacyWorkload(w http.ResponseWriter, r *http.Request, projectID, name string, rest []string) {
+	project, ok := s.projectName(projectID)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", projectID))
+		return
+	}
+	key := workloadKey(project, name)
+	switch {
+	case len(rest) == 0 && r.Method == http.MethodGet:
+		s.get(w, key, "")
+	case len(rest) == 0 && r.Method == http.MethodDelete:
+		s.delete(w, key)
+	case len(rest) == 1 && (r.Method == http.MethodPost || r.Method == http.MethodPut):
+		// publish and unpublish
+		s.action(w, key)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", r.Method, r.URL.Path))
+	}
+}
+
+// copyObject returns a copy of obj that can be changed without racing the
+// requests reading the stored object.
+func copyObject(obj map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(obj))
+	for k, v := range obj {
+		c[k] = v
+	}
+	return c
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	if len(body) == 0 {
+		return true
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return false
+	}
+	return true
+}
+
+// writeResults writes a page of a legacy list.
+func writeResults(w http.ResponseWriter, results []interface{}) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"count":    len(results),
+		"next":     nil,
+		"previous": nil,
+		"results":  results,
+	})
+}
@@ -0,0 +1,145 @@
+package fakehub
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+)
+
+func TestLegacyProjectLookup(t *testing.T) {
+	s := NewServer(t)
+	id := ProjectID(DefaultProject)
+
+	code, list := do(t, s, http.MethodGet, "/auth/v1/projects/?name="+DefaultProject, nil)
+	results, _ := list["results"].([]interface{})
+	if code != http.StatusOK || len(results) != 1 {
+		t.Fatalf("lookup by name: got %d %v", code, list)
+	}
+	if got := results[0].(map[string]interface{})["id"]; got != id {
+		t.Fatalf("lookup by name: got id %v, want %s", got, id)
+	}
+
+	code, list = do(t, s, http.MethodGet, "/auth/v1/projects/?name=missing", nil)
+	if code != http.StatusOK || len(list["results"].([]interface{})) != 0 {
+		t.Fatalf("lookup of a missing project: got %d %v", code, list)
+	}
+
+	if code, p := do(t, s, http.MethodGet, "/auth/v1/projects/"+id+"/", nil); code != http.StatusOK || p["name"] != DefaultProject {
+		t.Fatalf("get by id: got %d %v", code, p)
+	}
+
+	if code, _ := do(t, s, http.MethodDelete, "/auth/v1/projects/"+id+"/", nil); code != http.StatusOK {
+		t.Fatalf("delete by id: got %d, want 200", code)
+	}
+	if _, ok := s.Get(projectKey(DefaultProject)); ok {
+		t.Fatal("delete by id: v3 project still exists")
+	}
+}
+
+func TestLegacyEdgeOfCluster(t *testing.T) {
+	s := NewServer(t)
+	pid := ProjectID(DefaultProject)
+
+	c := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "c1"},
+		"spec":     map[string]interface{}{"type": "mks", "blueprint": map[string]interface{}{"name": "minimal"}},
+	}
+	if code, _ := do(t, s, http.MethodPut, "/apis/infra.k8smgmt.io/v3/projects/"+DefaultProject+"/clusters/c1", c); code != http.StatusOK {
+		t.Fatalf("apply: got %d, want 200", code)
+	}
+
+	code, edge := do(t, s, http.MethodGet, "/edge/v1/projects/"+pid+"/edges/c1/", nil)
+	if code != http.StatusOK || edge["id"] != ClusterID("c1") || edge["cluster_type"] != "mks" {
+		t.Fatalf("get edge by name: got %d %v", code, edge)
+	}
+
+	edge["settings"] = map[string]interface{}{"cluster.sharing.external": "false"}
+	if code, _ := do(t, s, http.MethodPut, "/edge/v1/projects/"+pid+"/edges/"+ClusterID("c1")+"/", edge); code != http.StatusOK {
+		t.Fatalf("update edge by id: got %d, want 200", code)
+	}
+	_, edge = do(t, s, http.MethodGet, "/edge/v1/projects/"+pid+"/edges/"+ClusterID("c1")+"/", nil)
+	if edge["settings"].(map[string]interface{})["cluster.sharing.external"] != "false" {
+		t.Fatalf("update edge: settings not kept: %v", edge)
+	}
+
+	if code, _ := do(t, s, http.MethodDelete, "/apis/infra.k8smgmt.io/v3/projects/"+DefaultProject+"/clusters/c1", nil); code != http.StatusOK {
+		t.Fatalf("delete: got %d, want 200", code)
+	}
+	if code, _ := do(t, s, http.MethodGet, "/edge/v1/projects/"+pid+"/edges/c1/", nil); code != http.StatusNotFound {
+		t.Fatalf("get edge after delete: got %d, want 404", code)
+	}
+}
+
+func TestLegacyImportedCluster(t *testing.T) {
+	s := NewServer(t)
+	pid := ProjectID(DefaultProject)
+
+	edge := map[string]interface{}{"name": "imported", "cluster_type": "imported"}
+	if code, _ := do(t, s, http.MethodPost, "/edge/v1/projects/"+pid+"/edges/", edge); code != http.StatusOK {
+		t.Fatalf("create: got %d, want 200", code)
+	}
+	if code, _ := do(t, s, http.MethodPost, "/edge/v1/projects/"+pid+"/edges/", edge); code != http.StatusConflict {
+		t.Fatalf("second create: got %d, want 409", code)
+	}
+
+	labels := map[string]interface{}{"env": "test"}
+	if code, _ := do(t, s, http.MethodPut, "/edge/v1/projects/"+pid+"/edges/"+ClusterID("imported")+"/labels/", labels); code != http.StatusOK {
+		t.Fatalf("labels: got %d, want 200", code)
+	}
+	code, c := do(t, s, http.MethodGet, "/v2/scheduler/project/"+pid+"/cluster/imported", nil)
+	if code != http.StatusOK || c["metadata"].(map[string]interface{})["labels"].(map[string]interface{})["env"] != "test" {
+		t.Fatalf("v2 cluster: got %d %v", code, c)
+	}
+
+	code, f := do(t, s, http.MethodGet, "/v2/scheduler/project/"+pid+"/cluster/imported/download/valuesyaml", nil)
+	if code != http.StatusOK {
+		t.Fatalf("download: got %d, want 200", code)
+	}
+	if _, err := base64.StdEncoding.DecodeString(f["data"].(string)); err != nil {
+		t.Fatalf("download: data is not base64: %v", err)
+	}
+
+	code, list := do(t, s, http.MethodGet, "/edge/v1/projects/"+pid+"/edges/?name=imported", nil)
+	if code != http.StatusOK || len(list["results"].([]interface{})) != 1 {
+		t.Fatalf("list: got %d %v", code, list)
+	}
+
+	if code, _ := do(t, s, http.MethodDelete, "/edge/v1/projects/"+pid+"/edges/"+ClusterID("imported")+"/", nil); code != http.StatusOK {
+		t.Fatalf("delete: got %d, want 200", code)
+	}
+	if _, ok := s.Edge(DefaultProject, "imported"); ok {
+		t.Fatal("delete: edge still exists")
+	}
+}
+
+func TestWorkloadActions(t *testing.T) {
+	s := NewServer(t)
+	base := "/apis/apps.k8smgmt.io/v3/projects/" + DefaultProject + "/workloads"
+
+	wl := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "wl1"},
+		"spec":     map[string]interface{}{"namespace": "ns1"},
+	}
+	if code, _ := do(t, s, http.MethodPut, base+"/wl1", wl); code != http.StatusOK {
+		t.Fatalf("apply: got %d, want 200", code)
+	}
+
+	if code, _ := do(t, s, http.MethodPost, base+"/wl1/publish", map[string]interface{}{}); code != http.StatusOK {
+		t.Fatalf("publish: got %d, want 200", code)
+	}
+	uri := "/v2/config/project/" + ProjectID(DefaultProject) + "/workload/wl1"
+	if code, _ := do(t, s, http.MethodPost, uri+"/unpublish", nil); code != http.StatusOK {
+		t.Fatalf("unpublish: got %d, want 200", code)
+	}
+	obj, _ := s.Get(workloadKey(DefaultProject, "wl1"))
+	if obj["spec"].(map[string]interface{})["namespace"] != "ns1" {
+		t.Fatalf("actions replaced the workload: %v", obj)
+	}
+
+	if code, _ := do(t, s, http.MethodDelete, uri, nil); code != http.StatusOK {
+		t.Fatalf("delete: got %d, want 200", code)
+	}
+	if code, _ := do(t, s, http.MethodPost, base+"/wl1/publish", nil); code != http.StatusNotFound {
+		t.Fatalf("publish after delete: got %d, want 404", code)
+	}
+}
@@ -0,0 +1,381 @@
+// Package fakehub is an in-process fake of the Rafay hub REST API for
+// offline acceptance tests.
+//
+// It implements the generic resource layout shared by the typed hub clients:
+//
+//	/apis/{group}/{version}/projects/{project}/{plural}[/{name}[/{subresource}]]
+//	/apis/{group}/{version}/{plural}[/{name}[/{subresource}]]
+//
+// Objects are kept as decoded JSON, so any kind served under that layout
+// (projects, clusters, blueprints, addons, namespaces, workloads,
+// environments, ...) round-trips without the fake knowing its schema. Apply
+// is a PUT or POST of the full object, Get and Delete address it by name and
+// the `status` subresource returns the stored object, other subresources such
+// as `publish` are accepted and leave the object as it is.
+//
+// The legacy rctl APIs the SDK resources still call, project and cluster
+// lookups by ID and the v2 workload endpoints, are served from the same
+// objects, see legacy.go. Other endpoints can be added per test with Handle.
+package fakehub
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// APIKey is the key the fake expects in the X-RAFAY-API-KEYID header.
+const APIKey = "fakehub-api-key"
+
+// DefaultProject is seeded into every server.
+const DefaultProject = "defaultproject"
+
+// Server is a running fake hub.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	objects  map[string]map[string]interface{}
+	handlers map[string]http.HandlerFunc
+	failures map[string]failure
+	// OnApply, when set, is called with every applied object before it is
+	// stored, e.g. to fill in a status the resource under test waits for.
+	OnApply func(key Key, obj map[string]interface{})
+}
+
+// Key addresses one object of the fake.
+type Key struct {
+	Group   string
+	Version string
+	Project string
+	Plural  string
+	Name    string
+}
+
+// failure is a status the next requests to a method and path fail with.
+type failure struct {
+	code  int
+	count int
+}
+
+func (k Key) collection() string {
+	return strings.Join([]string{k.Group, k.Version, k.Project, k.Plural}, "/")
+}
+
+func (k Key) String() string {
+	return k.collection() + "/" + k.Name
+}
+
+// NewServer starts a TLS fake hub that is closed when t finishes. The
+// default project is created up front.
+func NewServer(t *testing.T) *Server {
+	t.Helper()
+
+	s := &Server{
+		objects:  map[string]map[string]interface{}{},
+		handlers: map[string]http.HandlerFunc{},
+		failures: map[string]failure{},
+	}
+	s.Server = httptest.NewTLSServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	s.Seed(projectKey(DefaultProject), map[string]interface{}{
+		"apiVersion": "system.k8smgmt.io/v3",
+		"kind":       "Project",
+		"metadata":   map[string]interface{}{"name": DefaultProject},
+		"spec":       map[string]interface{}{"default": true},
+	})
+	return s
+}
+
+// Endpoint is the host:port to use as the provider rest_endpoint.
+func (s *Server) Endpoint() string {
+	u, _ := url.Parse(s.URL)
+	return u.Host
+}
+
+// ProviderConfig writes an rctl config file pointing at the fake and returns
+// the provider block to prepend to test configurations.
+func (s *Server) ProviderConfig(t *testing.T) string {
+	t.Helper()
+
+	cfg := map[string]string{
+		"profile":                "prod",
+		"rest_endpoint":          s.Endpoint(),
+		"ops_endpoint":           s.Endpoint(),
+		"api_key":                APIKey,
+		"api_secret":             "fakehub-api-secret",
+		"project":                DefaultProject,
+		"skip_server_cert_check": "true",
+	}
+	b, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("failed to marshal fake hub config: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, b, 0600); err != nil {
+		t.Fatalf("failed to write fake hub config: %v", err)
+	}
+
+	return fmt.Sprintf(`
+provider "rafay" {
+  provider_config_file      = %q
+  api_key                   = %q
+  rest_endpoint             = %q
+  ignore_insecure_tls_error = true
+}
+`, path, APIKey, s.Endpoint())
+}
+
+// Handle serves method and path with h instead of the generic object store.
+func (s *Server) Handle(method, path string, h http.HandlerFunc) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[method+" "+path] = h
+}
+
+// Fail makes the next n requests of method to path fail with the status
+// code, e.g. to check that a client retries them.
+func (s *Server) Fail(method, path string, n, code int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.failures[method+" "+path] = failure{code: code, count: n}
+}
+
+// PendingFailures returns how many of the failures set with Fail for method
+// and path have not been served yet.
+func (s *Server) PendingFailures(method, path string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.failures[method+" "+path].count
+}
+
+// Seed stores obj under key as if it had been applied.
+func (s *Server) Seed(key Key, obj map[string]interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.objects[key.String()] = obj
+}
+
+// Get returns the object stored under key.
+func (s *Server) Get(key Key) (map[string]interface{}, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	obj, ok := s.objects[key.String()]
+	return obj, ok
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	h, ok := s.handlers[r.Method+" "+r.URL.Path]
+	f, fail := s.failures[r.Method+" "+r.URL.Path]
+	fail = fail && f.count > 0
+	if fail {
+		f.count--
+		s.failures[r.Method+" "+r.URL.Path] = f
+	}
+	s.mu.Unlock()
+	if fail {
+		writeError(w, f.code, http.StatusText(f.code))
+		return
+	}
+	if ok {
+		h(w, r)
+		return
+	}
+
+	if r.Header.Get("X-RAFAY-API-KEYID") != APIKey {
+		writeError(w, http.StatusUnauthorized, "invalid api key")
+		return
+	}
+
+	if s.serveLegacy(w, r) {
+		return
+	}
+
+	key, sub, ok := parsePath(r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", r.Method, r.URL.Path))
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && key.Name == "":
+		s.list(w, key)
+	case r.Method == http.MethodGet:
+		s.get(w, key, sub)
+	case (r.Method == http.MethodPut || r.Method == http.MethodPost) && sub != "":
+		s.action(w, key)
+	case r.Method == http.MethodPut || r.Method == http.MethodPost:
+		s.apply(w, r, key)
+	case r.Method == http.MethodDelete:
+		s.delete(w, key)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, r.Method+" not allowed")
+	}
+}
+
+// parsePath splits a hub API path into the object key and an optional
+// subresource.
+func parsePath(p string) (Key, string, bool) {
+	parts := strings.Split(strings.Trim(p, "/"), "/")
+	if len(parts) < 4 || parts[0] != "apis" {
+		return Key{}, "", false
+	}
+	key := Key{Group: parts[1], Version: parts[2]}
+	rest := parts[3:]
+	if len(rest) >= 3 && rest[0] == "projects" {
+		key.Project = rest[1]
+		rest = rest[2:]
+	}
+
+	key.Plural = rest[0]
+	if len(rest) > 1 {
+		key.Name = rest[1]
+	}
+	var sub string
+	if len(rest) > 2 {
+		sub = strings.Join(rest[2:], "/")
+	}
+	return key, sub, true
+}
+
+// List returns the objects of the collection of key, ordered by name.
+func (s *Server) List(key Key) []map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	prefix := key.collection() + "/"
+	names := []string{}
+	for k := range s.objects {
+		if strings.HasPrefix(k, prefix) {
+			names = append(names, k)
+		}
+	}
+	sort.Strings(names)
+
+	objs := make([]map[string]interface{}, 0, len(names))
+	for _, n := range names {
+		objs = append(objs, s.objects[n])
+	}
+	return objs
+}
+
+func (s *Server) list(w http.ResponseWriter, key Key) {
+	items := []interface{}{}
+	for _, obj := range s.List(key) {
+		items = append(items, obj)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"metadata": map[string]interface{}{"count": len(items)},
+		"items":    items,
+	})
+}
+
+func (s *Server) get(w http.ResponseWriter, key Key, sub string) {
+	obj, ok := s.Get(key)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", key.Plural, key.Name))
+		return
+	}
+	if sub != "" && sub != "status" {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s/%s not found", key, sub))
+		return
+	}
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) apply(w http.ResponseWriter, r *http.Request, key Key) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	obj := map[string]interface{}{}
+	if err := json.Unmarshal(body, &obj); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	meta, _ := obj["metadata"].(map[string]interface{})
+	if meta == nil {
+		meta = map[string]interface{}{}
+		obj["metadata"] = meta
+	}
+	if key.Name == "" {
+		key.Name, _ = meta["name"].(string)
+	}
+	if key.Name == "" {
+		writeError(w, http.StatusBadRequest, "metadata.name is required")
+		return
+	}
+	meta["name"] = key.Name
+	if key.Project != "" {
+		if _, ok := s.Get(projectKey(key.Project)); !ok {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("project %s not found", key.Project))
+			return
+		}
+		meta["project"] = key.Project
+	}
+	// Resources poll the status of what they applied; an empty status decodes
+	// to StatusNotSet, which they treat as ready.
+	if _, ok := obj["status"]; !ok {
+		obj["status"] = map[string]interface{}{}
+	}
+
+	if s.OnApply != nil {
+		s.OnApply(key, obj)
+	}
+	s.Seed(key, obj)
+	writeJSON(w, http.StatusOK, obj)
+}
+
+// action serves a subresource such as `publish` or `unpublish` of the object
+// under key. The fake has nothing to roll out, so it only checks the object
+// exists.
+func (s *Server) action(w http.ResponseWriter, key Key) {
+	obj, ok := s.Get(key)
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", key.Plural, key.Name))
+		return
+	}
+	writeJSON(w, http.StatusOK, obj)
+}
+
+func (s *Server) delete(w http.ResponseWriter, key Key) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.objects[key.String()]; !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s not found", key.Plural, key.Name))
+		return
+	}
+	delete(s.objects, key.String())
+	if key == clusterKey(key.Project, key.Name) {
+		// The edge of a cluster goes with it, see legacy.go.
+		delete(s.objects, edgeKey(key.Project, key.Name).String())
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	writeJSON(w, code, map[string]interface{}{
+		"code":    code,
+		"message": msg,
+	})
+}
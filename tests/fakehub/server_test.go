@@ -0,0 +1,117 @@
+package fakehub
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+)
+
+func do(t *testing.T, s *Server, method, path string, body interface{}) (int, map[string]interface{}) {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&buf).Encode(body); err != nil {
+			t.Fatal(err)
+		}
+	}
+	req, err := http.NewRequest(method, s.URL+path, &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-RAFAY-API-KEYID", APIKey)
+	resp, err := s.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	out := map[string]interface{}{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, out
+}
+
+func TestObjectLifecycle(t *testing.T) {
+	s := NewServer(t)
+	base := "/apis/infra.k8smgmt.io/v3/projects/" + DefaultProject + "/namespaces"
+
+	code, _ := do(t, s, http.MethodGet, base+"/ns1", nil)
+	if code != http.StatusNotFound {
+		t.Fatalf("get before apply: got %d, want 404", code)
+	}
+
+	ns := map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "ns1"},
+		"spec":     map[string]interface{}{"drift": map[string]interface{}{"enabled": false}},
+	}
+	if code, _ := do(t, s, http.MethodPut, base+"/ns1", ns); code != http.StatusOK {
+		t.Fatalf("apply: got %d, want 200", code)
+	}
+
+	code, obj := do(t, s, http.MethodGet, base+"/ns1/status", nil)
+	if code != http.StatusOK {
+		t.Fatalf("status: got %d, want 200", code)
+	}
+	if obj["metadata"].(map[string]interface{})["project"] != DefaultProject {
+		t.Fatalf("status: project not set from path: %v", obj["metadata"])
+	}
+	if _, ok := obj["status"]; !ok {
+		t.Fatal("status: missing status")
+	}
+
+	code, list := do(t, s, http.MethodGet, base, nil)
+	if code != http.StatusOK || len(list["items"].([]interface{})) != 1 {
+		t.Fatalf("list: got %d %v", code, list)
+	}
+
+	if code, _ := do(t, s, http.MethodDelete, base+"/ns1", nil); code != http.StatusOK {
+		t.Fatalf("delete: got %d, want 200", code)
+	}
+	if code, _ := do(t, s, http.MethodDelete, base+"/ns1", nil); code != http.StatusNotFound {
+		t.Fatalf("second delete: got %d, want 404", code)
+	}
+}
+
+func TestApplyToUnknownProject(t *testing.T) {
+	s := NewServer(t)
+
+	ns := map[string]interface{}{"metadata": map[string]interface{}{"name": "ns1"}}
+	code, _ := do(t, s, http.MethodPut, "/apis/infra.k8smgmt.io/v3/projects/missing/namespaces/ns1", ns)
+	if code != http.StatusNotFound {
+		t.Fatalf("got %d, want 404", code)
+	}
+}
+
+func TestRejectsWrongAPIKey(t *testing.T) {
+	s := NewServer(t)
+
+	resp, err := s.Client().Get(s.URL + "/apis/system.k8smgmt.io/v3/projects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("got %d, want 401", resp.StatusCode)
+	}
+}
+
+func TestFail(t *testing.T) {
+	s := NewServer(t)
+	path := "/apis/infra.k8smgmt.io/v3/projects/" + DefaultProject + "/namespaces"
+
+	s.Fail(http.MethodGet, path, 2, http.StatusServiceUnavailable)
+	for i := 0; i < 2; i++ {
+		if code, _ := do(t, s, http.MethodGet, path, nil); code != http.StatusServiceUnavailable {
+			t.Fatalf("request %d: got %d, want 503", i+1, code)
+		}
+	}
+	if n := s.PendingFailures(http.MethodGet, path); n != 0 {
+		t.Fatalf("got %d pending failures, want 0", n)
+	}
+	if code, _ := do(t, s, http.MethodGet, path, nil); code != http.StatusOK {
+		t.Fatalf("request after the failures: got %d, want 200", code)
+	}
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrNotFound is the error a typed client returns for a missing object.
+var ErrNotFound = errors.New("code 404: not found")
+
+// Console keeps the last object applied through a mocked typed client, so
+// that reads return what was applied the way the console does. Tests change
+// the kept object to simulate drift made outside of Terraform.
+type Console[T proto.Message] struct {
+	mu  sync.Mutex
+	obj T
+	ok  bool
+}
+
+// Apply keeps a copy of obj.
+func (c *Console[T]) Apply(obj T) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.obj = proto.Clone(obj).(T)
+	c.ok = true
+}
+
+// Get returns a copy of the kept object, or ErrNotFound.
+func (c *Console[T]) Get() (T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.ok {
+		var zero T
+		return zero, ErrNotFound
+	}
+	return proto.Clone(c.obj).(T), nil
+}
+
+// Update changes the kept object in place, if there is one.
+func (c *Console[T]) Update(change func(T)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.ok {
+		change(c.obj)
+	}
+}
+
+// Delete forgets the kept object.
+func (c *Console[T]) Delete() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var zero T
+	c.obj, c.ok = zero, false
+}
+
+// Exists reports whether an object is kept.
+func (c *Console[T]) Exists() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ok
+}
+
+// CheckDestroy is a resource.TestCase CheckDestroy failing while an object
+// is kept.
+func (c *Console[T]) CheckDestroy(*terraform.State) error {
+	if c.Exists() {
+		return fmt.Errorf("object still exists after destroy")
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package addon
+
+import (
+	"embed"
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:embed testdata/*
+var addonFixtures embed.FS
+
+func addonConfig(t *testing.T) string {
+	return helpers.LoadFixture(t, addonFixtures, "testdata/addon.tf")
+}
+
+func TestResourceAddon(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*testing.T, addonTestConfig)
+	}{
+		{"create", testResourceAddonCreate},
+		{"drift", testResourceAddonDrift},
+		{"delete", testResourceAddonDelete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt := tt
+			tt.run(t, newAddonTestConfig())
+		})
+	}
+}
+
+func testResourceAddonCreate(t *testing.T, cfg addonTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: addonConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_addon.tftest", "id", "unit-addon"),
+					resource.TestCheckResourceAttr("rafay_addon.tftest", "spec.0.namespace", "unit-ns"),
+					resource.TestCheckResourceAttr("rafay_addon.tftest", "spec.0.artifact.0.artifact.0.chart_name", "busybox"),
+				),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Apply", mock.Anything, mock.MatchedBy(func(addon *infrapb.Addon) bool {
+		return addon.Metadata.Name == "unit-addon" && addon.Metadata.Project == "unit-project" && addon.Spec.Namespace == "unit-ns"
+	}), mock.Anything)
+}
+
+// testResourceAddonDrift changes the addon behind Terraform's back,
+// then checks that the change is planned away and the next apply restores
+// the configured addon.
+func testResourceAddonDrift(t *testing.T, cfg addonTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: addonConfig(t),
+			},
+			{
+				PreConfig: func() {
+					cfg.console.Update(func(addon *infrapb.Addon) {
+						addon.Spec.Namespace = "changed-ns"
+					})
+				},
+				Config:             addonConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: addonConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_addon.tftest", "spec.0.namespace", "unit-ns"),
+					func(*terraform.State) error {
+						addon, err := cfg.console.Get()
+						if err != nil {
+							return err
+						}
+						if addon.Spec.Namespace != "unit-ns" {
+							return fmt.Errorf("namespace: got %q, want unit-ns", addon.Spec.Namespace)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig:          cfg.console.Delete,
+				Config:             addonConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testResourceAddonDelete(t *testing.T, cfg addonTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: addonConfig(t),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Delete", mock.Anything, mock.MatchedBy(func(opts options.DeleteOptions) bool {
+		return opts.Name == "unit-addon" && opts.Project == "unit-project"
+	}))
+}
@@ -0,0 +1,90 @@
+package addon
+
+import (
+	"context"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	infrav3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockAddonClient is a mock of AddonClient interface. Methods the
+// tests do not expect are left to the embedded nil interface.
+type MockAddonClient struct {
+	infrav3.AddonClient
+	mock.Mock
+}
+
+func (m *MockAddonClient) Apply(ctx context.Context, addon *infrapb.Addon, opts options.ApplyOptions) error {
+	args := m.Called(ctx, addon, opts)
+	return args.Error(0)
+}
+
+func (m *MockAddonClient) Get(ctx context.Context, opts options.GetOptions) (*infrapb.Addon, error) {
+	args := m.Called(ctx, opts)
+	if get, ok := args.Get(0).(func() (*infrapb.Addon, error)); ok {
+		return get()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*infrapb.Addon), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockAddonClient) Delete(ctx context.Context, opts options.DeleteOptions) error {
+	args := m.Called(ctx, opts)
+	return args.Error(0)
+}
+
+func addonProviderFactoriesWithMock(mockClient *MockAddonClient) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"rafay": func() (*schema.Provider, error) {
+			provider := &schema.Provider{
+				Schema: rafay.Schema(),
+				ResourcesMap: map[string]*schema.Resource{
+					"rafay_addon": rafay.ResourceAddon(),
+				},
+				ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					return &rafay.ProviderMeta{
+						AddonClientFactory: func() (infrav3.AddonClient, error) {
+							return mockClient, nil
+						},
+					}, nil
+				},
+			}
+			return provider, nil
+		},
+	}
+}
+
+type addonTestConfig struct {
+	mockClient        *MockAddonClient
+	console           *helpers.Console[*infrapb.Addon]
+	providerFactories map[string]func() (*schema.Provider, error)
+}
+
+// newAddonTestConfig returns a mock that keeps the applied addon in
+// a console and forgets it on delete.
+func newAddonTestConfig() addonTestConfig {
+	mockClient := new(MockAddonClient)
+	console := &helpers.Console[*infrapb.Addon]{}
+
+	mockClient.On("Apply", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		console.Apply(args.Get(1).(*infrapb.Addon))
+	}).Return(nil)
+	mockClient.On("Get", mock.Anything, mock.Anything).Return(console.Get)
+	mockClient.On("Delete", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		console.Delete()
+	}).Return(nil)
+
+	return addonTestConfig{
+		mockClient:        mockClient,
+		console:           console,
+		providerFactories: addonProviderFactoriesWithMock(mockClient),
+	}
+}
@@ -0,0 +1,18 @@
+resource "rafay_addon" "tftest" {
+  metadata {
+    name    = "unit-addon"
+    project = "unit-project"
+  }
+  spec {
+    namespace = "unit-ns"
+    version   = "v1"
+    artifact {
+      type = "Helm"
+      artifact {
+        repository    = "unit-repo"
+        chart_name    = "busybox"
+        chart_version = "1.0.0"
+      }
+    }
+  }
+}
@@ -0,0 +1,102 @@
+package cluster
+
+import (
+	"embed"
+	"regexp"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:embed testdata/*
+var clusterFixtures embed.FS
+
+func gkeClusterConfig(t *testing.T) string {
+	return helpers.LoadFixture(t, clusterFixtures, "testdata/gke_cluster.tf")
+}
+
+func clusterFixture(t *testing.T, fileName string) *infrapb.Cluster {
+	return mustClusterFromJSON(t, helpers.LoadFixture(t, clusterFixtures, fileName))
+}
+
+func TestDataGKECluster(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*testing.T, clusterTestConfig)
+	}{
+		{"read", testDataGKEClusterRead},
+		{"drift", testDataGKEClusterDrift},
+		{"missing", testDataGKEClusterMissing},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt := tt
+			tt.run(t, newClusterTestConfig())
+		})
+	}
+}
+
+func testDataGKEClusterRead(t *testing.T, cfg clusterTestConfig) {
+	cfg.console.Apply(clusterFixture(t, "testdata/gke_cluster.json"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: gkeClusterConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rafay_gke_cluster.tftest", "id", "unit-gke"),
+					resource.TestCheckResourceAttr("data.rafay_gke_cluster.tftest", "spec.0.type", "gke"),
+					resource.TestCheckResourceAttr("data.rafay_gke_cluster.tftest", "spec.0.cloud_credentials", "unit-gcp-credentials"),
+					resource.TestCheckResourceAttr("data.rafay_gke_cluster.tftest", "spec.0.blueprint.0.name", "minimal"),
+				),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Get", mock.Anything, mock.MatchedBy(func(opts options.GetOptions) bool {
+		return opts.Name == "unit-gke" && opts.Project == "unit-project"
+	}))
+}
+
+// testDataGKEClusterDrift changes the cluster outside of Terraform and
+// checks that the next read reports the change.
+func testDataGKEClusterDrift(t *testing.T, cfg clusterTestConfig) {
+	cfg.console.Apply(clusterFixture(t, "testdata/gke_cluster.json"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: gkeClusterConfig(t),
+				Check:  resource.TestCheckResourceAttr("data.rafay_gke_cluster.tftest", "spec.0.blueprint.0.name", "minimal"),
+			},
+			{
+				PreConfig: func() {
+					cfg.console.Update(func(c *infrapb.Cluster) {
+						c.Spec.Blueprint.Name = "default"
+					})
+				},
+				Config: gkeClusterConfig(t),
+				Check:  resource.TestCheckResourceAttr("data.rafay_gke_cluster.tftest", "spec.0.blueprint.0.name", "default"),
+			},
+		},
+	})
+}
+
+func testDataGKEClusterMissing(t *testing.T, cfg clusterTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      gkeClusterConfig(t),
+				ExpectError: regexp.MustCompile("not found"),
+			},
+		},
+	})
+}
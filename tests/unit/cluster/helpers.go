@@ -0,0 +1,89 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	infrav3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MockClusterClient is a mock of ClusterClient interface. Methods the
+// tests do not expect are left to the embedded nil interface.
+type MockClusterClient struct {
+	infrav3.ClusterClient
+	mock.Mock
+}
+
+func (m *MockClusterClient) Get(ctx context.Context, opts options.GetOptions) (*infrapb.Cluster, error) {
+	args := m.Called(ctx, opts)
+	if get, ok := args.Get(0).(func() (*infrapb.Cluster, error)); ok {
+		return get()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*infrapb.Cluster), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// clusterProviderFactoriesWithMock serves the rafay_gke_cluster data source
+// only: the cluster resources look clusters up through the legacy edge API,
+// which the typed client does not cover.
+func clusterProviderFactoriesWithMock(mockClient *MockClusterClient) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"rafay": func() (*schema.Provider, error) {
+			p := rafay.New("test")()
+			provider := &schema.Provider{
+				Schema: rafay.Schema(),
+				DataSourcesMap: map[string]*schema.Resource{
+					"rafay_gke_cluster": p.DataSourcesMap["rafay_gke_cluster"],
+				},
+				ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					return &rafay.ProviderMeta{
+						ClusterClientFactory: func() (infrav3.ClusterClient, error) {
+							return mockClient, nil
+						},
+					}, nil
+				},
+			}
+			return provider, nil
+		},
+	}
+}
+
+type clusterTestConfig struct {
+	mockClient        *MockClusterClient
+	console           *helpers.Console[*infrapb.Cluster]
+	providerFactories map[string]func() (*schema.Provider, error)
+}
+
+// newClusterTestConfig returns a mock reading the cluster kept in a
+// console.
+func newClusterTestConfig() clusterTestConfig {
+	mockClient := new(MockClusterClient)
+	console := &helpers.Console[*infrapb.Cluster]{}
+
+	mockClient.On("Get", mock.Anything, mock.Anything).Return(console.Get)
+
+	return clusterTestConfig{
+		mockClient:        mockClient,
+		console:           console,
+		providerFactories: clusterProviderFactoriesWithMock(mockClient),
+	}
+}
+
+func mustClusterFromJSON(t *testing.T, payload string) *infrapb.Cluster {
+	t.Helper()
+	c := &infrapb.Cluster{}
+	if err := protojson.Unmarshal([]byte(payload), c); err != nil {
+		t.Fatalf("failed to unmarshal cluster JSON: %v", err)
+	}
+	return c
+}
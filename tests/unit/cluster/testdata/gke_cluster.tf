@@ -0,0 +1,6 @@
+data "rafay_gke_cluster" "tftest" {
+  metadata {
+    name    = "unit-gke"
+    project = "unit-project"
+  }
+}
@@ -0,0 +1,124 @@
+package credentials
+
+import (
+	"embed"
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:embed testdata/*
+var credentialsFixtures embed.FS
+
+func credentialsConfig(t *testing.T) string {
+	return helpers.LoadFixture(t, credentialsFixtures, "testdata/credentials.tf")
+}
+
+func TestResourceCredentials(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*testing.T, credentialsTestConfig)
+	}{
+		{"create", testResourceCredentialsCreate},
+		{"drift", testResourceCredentialsDrift},
+		{"delete", testResourceCredentialsDelete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt := tt
+			tt.run(t, newCredentialsTestConfig())
+		})
+	}
+}
+
+func testResourceCredentialsCreate(t *testing.T, cfg credentialsTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: credentialsConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_cloud_credentials_v3.tftest", "id", "unit-credentials"),
+					resource.TestCheckResourceAttr("rafay_cloud_credentials_v3.tftest", "spec.0.provider", "aws"),
+					resource.TestCheckResourceAttr("rafay_cloud_credentials_v3.tftest", "spec.0.credentials.0.type", "RoleBased"),
+					resource.TestCheckResourceAttr("rafay_cloud_credentials_v3.tftest", "spec.0.credentials.0.arn", "arn:aws:iam::123456789012:role/unit-role"),
+				),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Apply", mock.Anything, mock.MatchedBy(func(credentials *infrapb.Credentials) bool {
+		return credentials.Metadata.Name == "unit-credentials" && credentials.Metadata.Project == "unit-project" && credentials.Spec.Provider == "aws"
+	}), mock.Anything)
+}
+
+// testResourceCredentialsDrift changes the credentials behind Terraform's
+// back, then checks that the change is planned away and the next apply
+// restores the configured credentials.
+func testResourceCredentialsDrift(t *testing.T, cfg credentialsTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: credentialsConfig(t),
+			},
+			{
+				PreConfig: func() {
+					cfg.console.Update(func(credentials *infrapb.Credentials) {
+						credentials.Spec.Type = "DataBackup"
+					})
+				},
+				Config:             credentialsConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: credentialsConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_cloud_credentials_v3.tftest", "spec.0.type", "ClusterProvisioning"),
+					func(*terraform.State) error {
+						credentials, err := cfg.console.Get()
+						if err != nil {
+							return err
+						}
+						if credentials.Spec.Type != "ClusterProvisioning" {
+							return fmt.Errorf("type: got %q, want ClusterProvisioning", credentials.Spec.Type)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig:          cfg.console.Delete,
+				Config:             credentialsConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testResourceCredentialsDelete(t *testing.T, cfg credentialsTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: credentialsConfig(t),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Delete", mock.Anything, mock.MatchedBy(func(opts options.DeleteOptions) bool {
+		return opts.Name == "unit-credentials" && opts.Project == "unit-project"
+	}))
+}
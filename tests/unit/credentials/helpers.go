@@ -0,0 +1,91 @@
+package credentials
+
+import (
+	"context"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	infrav3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockCredentialsClient is a mock of CredentialsClient interface. Methods the
+// tests do not expect are left to the embedded nil interface.
+type MockCredentialsClient struct {
+	infrav3.CredentialsClient
+	mock.Mock
+}
+
+func (m *MockCredentialsClient) Apply(ctx context.Context, credentials *infrapb.Credentials, opts options.ApplyOptions) error {
+	args := m.Called(ctx, credentials, opts)
+	return args.Error(0)
+}
+
+func (m *MockCredentialsClient) Get(ctx context.Context, opts options.GetOptions) (*infrapb.Credentials, error) {
+	args := m.Called(ctx, opts)
+	if get, ok := args.Get(0).(func() (*infrapb.Credentials, error)); ok {
+		return get()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*infrapb.Credentials), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockCredentialsClient) Delete(ctx context.Context, opts options.DeleteOptions) error {
+	args := m.Called(ctx, opts)
+	return args.Error(0)
+}
+
+func credentialsProviderFactoriesWithMock(mockClient *MockCredentialsClient) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"rafay": func() (*schema.Provider, error) {
+			p := rafay.New("test")()
+			provider := &schema.Provider{
+				Schema: rafay.Schema(),
+				ResourcesMap: map[string]*schema.Resource{
+					"rafay_cloud_credentials_v3": p.ResourcesMap["rafay_cloud_credentials_v3"],
+				},
+				ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					return &rafay.ProviderMeta{
+						CredentialsClientFactory: func() (infrav3.CredentialsClient, error) {
+							return mockClient, nil
+						},
+					}, nil
+				},
+			}
+			return provider, nil
+		},
+	}
+}
+
+type credentialsTestConfig struct {
+	mockClient        *MockCredentialsClient
+	console           *helpers.Console[*infrapb.Credentials]
+	providerFactories map[string]func() (*schema.Provider, error)
+}
+
+// newCredentialsTestConfig returns a mock that keeps the applied credentials
+// in a console and forgets them on delete.
+func newCredentialsTestConfig() credentialsTestConfig {
+	mockClient := new(MockCredentialsClient)
+	console := &helpers.Console[*infrapb.Credentials]{}
+
+	mockClient.On("Apply", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		console.Apply(args.Get(1).(*infrapb.Credentials))
+	}).Return(nil)
+	mockClient.On("Get", mock.Anything, mock.Anything).Return(console.Get)
+	mockClient.On("Delete", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		console.Delete()
+	}).Return(nil)
+
+	return credentialsTestConfig{
+		mockClient:        mockClient,
+		console:           console,
+		providerFactories: credentialsProviderFactoriesWithMock(mockClient),
+	}
+}
@@ -0,0 +1,17 @@
+resource "rafay_cloud_credentials_v3" "tftest" {
+  metadata {
+    name    = "unit-credentials"
+    project = "unit-project"
+  }
+  spec {
+    type     = "ClusterProvisioning"
+    provider = "aws"
+    credentials {
+      type = "RoleBased"
+      arn  = "arn:aws:iam::123456789012:role/unit-role"
+    }
+    sharing {
+      enabled = false
+    }
+  }
+}
@@ -0,0 +1,125 @@
+package environment
+
+import (
+	"embed"
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/eaaspb"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:embed testdata/*
+var environmentFixtures embed.FS
+
+func environmentConfig(t *testing.T) string {
+	return helpers.LoadFixture(t, environmentFixtures, "testdata/environment.tf")
+}
+
+func TestResourceEnvironment(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*testing.T, environmentTestConfig)
+	}{
+		{"create", testResourceEnvironmentCreate},
+		{"drift", testResourceEnvironmentDrift},
+		{"delete", testResourceEnvironmentDelete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt := tt
+			status := mustEnvironmentFromJSON(t, helpers.LoadFixture(t, environmentFixtures, "testdata/published_status.json"))
+			tt.run(t, newEnvironmentTestConfig(status))
+		})
+	}
+}
+
+func testResourceEnvironmentCreate(t *testing.T, cfg environmentTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: environmentConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_environment.tftest", "id", "unit-environment"),
+					resource.TestCheckResourceAttr("rafay_environment.tftest", "spec.0.template.0.version", "v1"),
+					resource.TestCheckResourceAttr("rafay_environment.tftest", "status", "StatusOK"),
+					resource.TestCheckResourceAttr("rafay_environment.tftest", "reason", "environment published"),
+				),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Apply", mock.Anything, mock.MatchedBy(func(env *eaaspb.Environment) bool {
+		return env.Metadata.Name == "unit-environment" && env.Metadata.Project == "unit-project" && env.Spec.Template.Name == "unit-template"
+	}), mock.Anything)
+}
+
+// testResourceEnvironmentDrift changes the template version behind
+// Terraform's back, then checks that the change is planned away and the
+// next apply restores the configured version.
+func testResourceEnvironmentDrift(t *testing.T, cfg environmentTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: environmentConfig(t),
+			},
+			{
+				PreConfig: func() {
+					cfg.console.Update(func(env *eaaspb.Environment) {
+						env.Spec.Template.Version = "v2"
+					})
+				},
+				Config:             environmentConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: environmentConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_environment.tftest", "spec.0.template.0.version", "v1"),
+					func(*terraform.State) error {
+						env, err := cfg.console.Get()
+						if err != nil {
+							return err
+						}
+						if env.Spec.Template.Version != "v1" {
+							return fmt.Errorf("template version: got %q, want v1", env.Spec.Template.Version)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig:          cfg.console.Delete,
+				Config:             environmentConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testResourceEnvironmentDelete(t *testing.T, cfg environmentTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: environmentConfig(t),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Delete", mock.Anything, mock.MatchedBy(func(opts options.DeleteOptions) bool {
+		return opts.Name == "unit-environment" && opts.Project == "unit-project"
+	}))
+}
@@ -0,0 +1,120 @@
+package environment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	eaasv1 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/eaas/v1"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/eaaspb"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MockEnvironmentClient is a mock of EnvironmentClient interface. Methods
+// the tests do not expect are left to the embedded nil interface.
+type MockEnvironmentClient struct {
+	eaasv1.EnvironmentClient
+	mock.Mock
+}
+
+func (m *MockEnvironmentClient) Apply(ctx context.Context, environment *eaaspb.Environment, opts options.ApplyOptions) error {
+	args := m.Called(ctx, environment, opts)
+	return args.Error(0)
+}
+
+func (m *MockEnvironmentClient) Get(ctx context.Context, opts options.GetOptions) (*eaaspb.Environment, error) {
+	args := m.Called(ctx, opts)
+	if get, ok := args.Get(0).(func() (*eaaspb.Environment, error)); ok {
+		return get()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*eaaspb.Environment), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockEnvironmentClient) Status(ctx context.Context, opts options.StatusOptions) (*eaaspb.Environment, error) {
+	args := m.Called(ctx, opts)
+	if status, ok := args.Get(0).(func() (*eaaspb.Environment, error)); ok {
+		return status()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*eaaspb.Environment), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockEnvironmentClient) Delete(ctx context.Context, opts options.DeleteOptions) error {
+	args := m.Called(ctx, opts)
+	return args.Error(0)
+}
+
+func environmentProviderFactoriesWithMock(mockClient *MockEnvironmentClient) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"rafay": func() (*schema.Provider, error) {
+			p := rafay.New("test")()
+			provider := &schema.Provider{
+				Schema: rafay.Schema(),
+				ResourcesMap: map[string]*schema.Resource{
+					"rafay_environment": p.ResourcesMap["rafay_environment"],
+				},
+				ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					return &rafay.ProviderMeta{
+						EnvironmentClientFactory: func() (eaasv1.EnvironmentClient, error) {
+							return mockClient, nil
+						},
+					}, nil
+				},
+			}
+			return provider, nil
+		},
+	}
+}
+
+type environmentTestConfig struct {
+	mockClient        *MockEnvironmentClient
+	console           *helpers.Console[*eaaspb.Environment]
+	providerFactories map[string]func() (*schema.Provider, error)
+}
+
+// newEnvironmentTestConfig returns a mock that keeps the applied
+// environment in a console, reports status while it is kept and forgets it
+// on delete, so that the destroy wait sees it gone.
+func newEnvironmentTestConfig(status *eaaspb.Environment) environmentTestConfig {
+	mockClient := new(MockEnvironmentClient)
+	console := &helpers.Console[*eaaspb.Environment]{}
+
+	mockClient.On("Apply", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		console.Apply(args.Get(1).(*eaaspb.Environment))
+	}).Return(nil)
+	mockClient.On("Get", mock.Anything, mock.Anything).Return(console.Get)
+	mockClient.On("Status", mock.Anything, mock.Anything).Return(func() (*eaaspb.Environment, error) {
+		if !console.Exists() {
+			return nil, helpers.ErrNotFound
+		}
+		return status, nil
+	})
+	mockClient.On("Delete", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		console.Delete()
+	}).Return(nil)
+
+	return environmentTestConfig{
+		mockClient:        mockClient,
+		console:           console,
+		providerFactories: environmentProviderFactoriesWithMock(mockClient),
+	}
+}
+
+func mustEnvironmentFromJSON(t *testing.T, payload string) *eaaspb.Environment {
+	t.Helper()
+	env := &eaaspb.Environment{}
+	if err := protojson.Unmarshal([]byte(payload), env); err != nil {
+		t.Fatalf("failed to unmarshal environment JSON: %v", err)
+	}
+	return env
+}
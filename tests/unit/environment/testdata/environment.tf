@@ -0,0 +1,12 @@
+resource "rafay_environment" "tftest" {
+  metadata {
+    name    = "unit-environment"
+    project = "unit-project"
+  }
+  spec {
+    template {
+      name    = "unit-template"
+      version = "v1"
+    }
+  }
+}
@@ -0,0 +1,94 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	infrav3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/infra/v3"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MockNamespaceClient is a mock of NamespaceClient interface. Methods the
+// tests do not expect are left to the embedded nil interface.
+type MockNamespaceClient struct {
+	infrav3.NamespaceClient
+	mock.Mock
+}
+
+func (m *MockNamespaceClient) List(ctx context.Context, opts options.ListOptions) (*infrapb.NamespaceList, error) {
+	args := m.Called(ctx, opts)
+	if list, ok := args.Get(0).(func() (*infrapb.NamespaceList, error)); ok {
+		return list()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*infrapb.NamespaceList), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// namespaceProviderFactoriesWithMock serves the rafay_namespaces data
+// source. It looks the project up through the legacy API first, so the
+// provider is configured as usual, against a fake hub, and only the typed
+// namespace client is replaced by the mock.
+func namespaceProviderFactoriesWithMock(mockClient *MockNamespaceClient) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"rafay": func() (*schema.Provider, error) {
+			p := rafay.New("test")()
+			provider := &schema.Provider{
+				Schema: rafay.Schema(),
+				DataSourcesMap: map[string]*schema.Resource{
+					"rafay_namespaces": p.DataSourcesMap["rafay_namespaces"],
+				},
+				ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					m, diags := rafay.ProviderConfigure(ctx, d)
+					if diags.HasError() {
+						return nil, diags
+					}
+					meta := m.(*rafay.ProviderMeta)
+					meta.NamespaceClientFactory = func() (infrav3.NamespaceClient, error) {
+						return mockClient, nil
+					}
+					return meta, diags
+				},
+			}
+			return provider, nil
+		},
+	}
+}
+
+type namespaceTestConfig struct {
+	mockClient        *MockNamespaceClient
+	console           *helpers.Console[*infrapb.NamespaceList]
+	providerFactories map[string]func() (*schema.Provider, error)
+}
+
+// newNamespaceTestConfig returns a mock listing the namespaces kept in a
+// console.
+func newNamespaceTestConfig() namespaceTestConfig {
+	mockClient := new(MockNamespaceClient)
+	console := &helpers.Console[*infrapb.NamespaceList]{}
+
+	mockClient.On("List", mock.Anything, mock.Anything).Return(console.Get)
+
+	return namespaceTestConfig{
+		mockClient:        mockClient,
+		console:           console,
+		providerFactories: namespaceProviderFactoriesWithMock(mockClient),
+	}
+}
+
+func mustNamespaceListFromJSON(t *testing.T, payload string) *infrapb.NamespaceList {
+	t.Helper()
+	list := &infrapb.NamespaceList{}
+	if err := protojson.Unmarshal([]byte(payload), list); err != nil {
+		t.Fatalf("failed to unmarshal namespace list JSON: %v", err)
+	}
+	return list
+}
@@ -0,0 +1,100 @@
+package namespace
+
+import (
+	"embed"
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/infrapb"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/fakehub"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:embed testdata/*
+var namespaceFixtures embed.FS
+
+// namespacesConfig configures the provider against hub, which answers the
+// legacy project lookup.
+func namespacesConfig(t *testing.T, hub *fakehub.Server) string {
+	fixture := helpers.LoadFixture(t, namespaceFixtures, "testdata/namespaces.tf")
+	return hub.ProviderConfig(t) + fmt.Sprintf(fixture, fakehub.DefaultProject)
+}
+
+func namespaceListFixture(t *testing.T, fileName string) *infrapb.NamespaceList {
+	return mustNamespaceListFromJSON(t, helpers.LoadFixture(t, namespaceFixtures, fileName))
+}
+
+// The subtests do not run in parallel: configuring the provider sets the
+// process-wide console endpoint to the fake hub of the subtest.
+func TestDataNamespaces(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*testing.T, namespaceTestConfig)
+	}{
+		{"read", testDataNamespacesRead},
+		{"drift", testDataNamespacesDrift},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.run(t, newNamespaceTestConfig())
+		})
+	}
+}
+
+func testDataNamespacesRead(t *testing.T, cfg namespaceTestConfig) {
+	cfg.console.Apply(namespaceListFixture(t, "testdata/namespaces.json"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: namespacesConfig(t, fakehub.NewServer(t)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "id", fakehub.ProjectID(fakehub.DefaultProject)),
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.0.name", "unit-ns-1"),
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.0.type", "Wizard"),
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.0.deployed_clusters", "unit-cluster-1, unit-cluster-2"),
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.0.labels.team", "platform"),
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.1.name", "unit-ns-2"),
+				),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "List", mock.Anything, mock.MatchedBy(func(opts options.ListOptions) bool {
+		return opts.Project == fakehub.DefaultProject
+	}))
+}
+
+// testDataNamespacesDrift removes a namespace outside of Terraform and
+// checks that the next read no longer lists it.
+func testDataNamespacesDrift(t *testing.T, cfg namespaceTestConfig) {
+	config := namespacesConfig(t, fakehub.NewServer(t))
+	cfg.console.Apply(namespaceListFixture(t, "testdata/namespaces.json"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.#", "2"),
+			},
+			{
+				PreConfig: func() {
+					cfg.console.Update(func(list *infrapb.NamespaceList) {
+						list.Items = list.Items[:1]
+					})
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.#", "1"),
+					resource.TestCheckResourceAttr("data.rafay_namespaces.tftest", "namespaces.0.name", "unit-ns-1"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,3 @@
+data "rafay_namespaces" "tftest" {
+  projectname = %q
+}
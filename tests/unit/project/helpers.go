@@ -0,0 +1,89 @@
+package project
+
+import (
+	"context"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	systemv3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/system/v3"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// MockProjectClient is a mock of ProjectClient interface. Methods the
+// tests do not expect are left to the embedded nil interface.
+type MockProjectClient struct {
+	systemv3.ProjectClient
+	mock.Mock
+}
+
+func (m *MockProjectClient) Get(ctx context.Context, opts options.GetOptions) (*systempb.Project, error) {
+	args := m.Called(ctx, opts)
+	if get, ok := args.Get(0).(func() (*systempb.Project, error)); ok {
+		return get()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*systempb.Project), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// projectProviderFactoriesWithMock serves the rafay_project data source
+// only: the resource deletes projects by ID through the legacy API, which
+// the typed client does not cover.
+func projectProviderFactoriesWithMock(mockClient *MockProjectClient) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"rafay": func() (*schema.Provider, error) {
+			p := rafay.New("test")()
+			provider := &schema.Provider{
+				Schema: rafay.Schema(),
+				DataSourcesMap: map[string]*schema.Resource{
+					"rafay_project": p.DataSourcesMap["rafay_project"],
+				},
+				ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					return &rafay.ProviderMeta{
+						ProjectClientFactory: func() (systemv3.ProjectClient, error) {
+							return mockClient, nil
+						},
+					}, nil
+				},
+			}
+			return provider, nil
+		},
+	}
+}
+
+type projectTestConfig struct {
+	mockClient        *MockProjectClient
+	console           *helpers.Console[*systempb.Project]
+	providerFactories map[string]func() (*schema.Provider, error)
+}
+
+// newProjectTestConfig returns a mock reading the project kept in a
+// console.
+func newProjectTestConfig() projectTestConfig {
+	mockClient := new(MockProjectClient)
+	console := &helpers.Console[*systempb.Project]{}
+
+	mockClient.On("Get", mock.Anything, mock.Anything).Return(console.Get)
+
+	return projectTestConfig{
+		mockClient:        mockClient,
+		console:           console,
+		providerFactories: projectProviderFactoriesWithMock(mockClient),
+	}
+}
+
+func mustProjectFromJSON(t *testing.T, payload string) *systempb.Project {
+	t.Helper()
+	p := &systempb.Project{}
+	if err := protojson.Unmarshal([]byte(payload), p); err != nil {
+		t.Fatalf("failed to unmarshal project JSON: %v", err)
+	}
+	return p
+}
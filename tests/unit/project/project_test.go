@@ -0,0 +1,87 @@
+package project
+
+import (
+	"embed"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/systempb"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:embed testdata/*
+var projectFixtures embed.FS
+
+func projectConfig(t *testing.T) string {
+	return helpers.LoadFixture(t, projectFixtures, "testdata/project.tf")
+}
+
+func projectFixture(t *testing.T, fileName string) *systempb.Project {
+	return mustProjectFromJSON(t, helpers.LoadFixture(t, projectFixtures, fileName))
+}
+
+func TestDataProject(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*testing.T, projectTestConfig)
+	}{
+		{"read", testDataProjectRead},
+		{"drift", testDataProjectDrift},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt := tt
+			tt.run(t, newProjectTestConfig())
+		})
+	}
+}
+
+func testDataProjectRead(t *testing.T, cfg projectTestConfig) {
+	cfg.console.Apply(projectFixture(t, "testdata/project.json"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.rafay_project.tftest", "id", "unit-project"),
+					resource.TestCheckResourceAttr("data.rafay_project.tftest", "metadata.0.description", "unit test project"),
+					resource.TestCheckResourceAttr("data.rafay_project.tftest", "spec.0.default", "false"),
+				),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Get", mock.Anything, mock.MatchedBy(func(opts options.GetOptions) bool {
+		return opts.Name == "unit-project"
+	}))
+}
+
+// testDataProjectDrift changes the project outside of Terraform and checks
+// that the next read reports the change.
+func testDataProjectDrift(t *testing.T, cfg projectTestConfig) {
+	cfg.console.Apply(projectFixture(t, "testdata/project.json"))
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: projectConfig(t),
+				Check:  resource.TestCheckResourceAttr("data.rafay_project.tftest", "metadata.0.description", "unit test project"),
+			},
+			{
+				PreConfig: func() {
+					cfg.console.Update(func(p *systempb.Project) {
+						p.Metadata.Description = "changed outside of terraform"
+					})
+				},
+				Config: projectConfig(t),
+				Check:  resource.TestCheckResourceAttr("data.rafay_project.tftest", "metadata.0.description", "changed outside of terraform"),
+			},
+		},
+	})
+}
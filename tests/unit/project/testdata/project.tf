@@ -0,0 +1,5 @@
+data "rafay_project" "tftest" {
+  metadata {
+    name = "unit-project"
+  }
+}
@@ -0,0 +1,100 @@
+package workload
+
+import (
+	"context"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	appsv3 "github.com/RafaySystems/rafay-common/pkg/hub/client/typed/apps/v3"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/appspb"
+	"github.com/RafaySystems/terraform-provider-rafay/rafay"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/mock"
+)
+
+// MockWorkloadClient is a mock of WorkloadClient interface. Methods the
+// tests do not expect are left to the embedded nil interface.
+type MockWorkloadClient struct {
+	appsv3.WorkloadClient
+	mock.Mock
+}
+
+func (m *MockWorkloadClient) Apply(ctx context.Context, workload *appspb.Workload, opts options.ApplyOptions) error {
+	args := m.Called(ctx, workload, opts)
+	return args.Error(0)
+}
+
+func (m *MockWorkloadClient) Get(ctx context.Context, opts options.GetOptions) (*appspb.Workload, error) {
+	args := m.Called(ctx, opts)
+	if get, ok := args.Get(0).(func() (*appspb.Workload, error)); ok {
+		return get()
+	}
+	if args.Get(0) != nil {
+		return args.Get(0).(*appspb.Workload), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWorkloadClient) Status(ctx context.Context, opts options.StatusOptions) (*appspb.Workload, error) {
+	args := m.Called(ctx, opts)
+	if args.Get(0) != nil {
+		return args.Get(0).(*appspb.Workload), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *MockWorkloadClient) Delete(ctx context.Context, opts options.DeleteOptions) error {
+	args := m.Called(ctx, opts)
+	return args.Error(0)
+}
+
+func workloadProviderFactoriesWithMock(mockClient *MockWorkloadClient) map[string]func() (*schema.Provider, error) {
+	return map[string]func() (*schema.Provider, error){
+		"rafay": func() (*schema.Provider, error) {
+			p := rafay.New("test")()
+			provider := &schema.Provider{
+				Schema: rafay.Schema(),
+				ResourcesMap: map[string]*schema.Resource{
+					"rafay_workload": p.ResourcesMap["rafay_workload"],
+				},
+				ConfigureContextFunc: func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+					return &rafay.ProviderMeta{
+						WorkloadClientFactory: func() (appsv3.WorkloadClient, error) {
+							return mockClient, nil
+						},
+					}, nil
+				},
+			}
+			return provider, nil
+		},
+	}
+}
+
+type workloadTestConfig struct {
+	mockClient        *MockWorkloadClient
+	console           *helpers.Console[*appspb.Workload]
+	providerFactories map[string]func() (*schema.Provider, error)
+}
+
+// newWorkloadTestConfig returns a mock that keeps the applied workload in
+// a console, reports it published and forgets it on delete.
+func newWorkloadTestConfig() workloadTestConfig {
+	mockClient := new(MockWorkloadClient)
+	console := &helpers.Console[*appspb.Workload]{}
+
+	mockClient.On("Apply", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		console.Apply(args.Get(1).(*appspb.Workload))
+	}).Return(nil)
+	mockClient.On("Status", mock.Anything, mock.Anything).Return(&appspb.Workload{}, nil)
+	mockClient.On("Get", mock.Anything, mock.Anything).Return(console.Get)
+	mockClient.On("Delete", mock.Anything, mock.Anything).Run(func(mock.Arguments) {
+		console.Delete()
+	}).Return(nil)
+
+	return workloadTestConfig{
+		mockClient:        mockClient,
+		console:           console,
+		providerFactories: workloadProviderFactoriesWithMock(mockClient),
+	}
+}
@@ -0,0 +1,21 @@
+resource "rafay_workload" "tftest" {
+  metadata {
+    name    = "unit-workload"
+    project = "unit-project"
+  }
+  spec {
+    namespace = "unit-ns"
+    version   = "v1"
+    placement {
+      selector = "rafay.dev/clusterName=unit-cluster"
+    }
+    artifact {
+      type = "Helm"
+      artifact {
+        repository    = "unit-repo"
+        chart_name    = "busybox"
+        chart_version = "1.0.0"
+      }
+    }
+  }
+}
@@ -0,0 +1,126 @@
+package workload
+
+import (
+	"embed"
+	"fmt"
+	"testing"
+
+	"github.com/RafaySystems/rafay-common/pkg/hub/client/options"
+	"github.com/RafaySystems/rafay-common/proto/types/hub/appspb"
+	"github.com/RafaySystems/terraform-provider-rafay/tests/helpers"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/mock"
+)
+
+//go:embed testdata/*
+var workloadFixtures embed.FS
+
+func workloadConfig(t *testing.T) string {
+	return helpers.LoadFixture(t, workloadFixtures, "testdata/workload.tf")
+}
+
+func TestResourceWorkload(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(*testing.T, workloadTestConfig)
+	}{
+		{"create", testResourceWorkloadCreate},
+		{"drift", testResourceWorkloadDrift},
+		{"delete", testResourceWorkloadDelete},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt := tt
+			tt.run(t, newWorkloadTestConfig())
+		})
+	}
+}
+
+func testResourceWorkloadCreate(t *testing.T, cfg workloadTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: workloadConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_workload.tftest", "id", "unit-workload"),
+					resource.TestCheckResourceAttr("rafay_workload.tftest", "spec.0.namespace", "unit-ns"),
+					resource.TestCheckResourceAttr("rafay_workload.tftest", "spec.0.artifact.0.artifact.0.chart_name", "busybox"),
+				),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Apply", mock.Anything, mock.MatchedBy(func(wl *appspb.Workload) bool {
+		return wl.Metadata.Name == "unit-workload" && wl.Metadata.Project == "unit-project" && wl.Spec.Namespace == "unit-ns"
+	}), mock.Anything)
+	cfg.mockClient.AssertCalled(t, "Status", mock.Anything, mock.MatchedBy(func(opts options.StatusOptions) bool {
+		return opts.Name == "unit-workload" && opts.Project == "unit-project"
+	}))
+}
+
+// testResourceWorkloadDrift changes the workload behind Terraform's back,
+// then checks that the change is planned away and the next apply restores
+// the configured workload.
+func testResourceWorkloadDrift(t *testing.T, cfg workloadTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: workloadConfig(t),
+			},
+			{
+				PreConfig: func() {
+					cfg.console.Update(func(wl *appspb.Workload) {
+						wl.Spec.Namespace = "changed-ns"
+					})
+				},
+				Config:             workloadConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: workloadConfig(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("rafay_workload.tftest", "spec.0.namespace", "unit-ns"),
+					func(*terraform.State) error {
+						wl, err := cfg.console.Get()
+						if err != nil {
+							return err
+						}
+						if wl.Spec.Namespace != "unit-ns" {
+							return fmt.Errorf("namespace: got %q, want unit-ns", wl.Spec.Namespace)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig:          cfg.console.Delete,
+				Config:             workloadConfig(t),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testResourceWorkloadDelete(t *testing.T, cfg workloadTestConfig) {
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: cfg.providerFactories,
+		CheckDestroy:      cfg.console.CheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: workloadConfig(t),
+			},
+		},
+	})
+
+	cfg.mockClient.AssertCalled(t, "Delete", mock.Anything, mock.MatchedBy(func(opts options.DeleteOptions) bool {
+		return opts.Name == "unit-workload" && opts.Project == "unit-project"
+	}))
+}